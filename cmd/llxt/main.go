@@ -3,12 +3,17 @@ package main
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"os"
 
 	"github.com/urfave/cli/v3"
 
 	llxtcli "github.com/g5becks/llxt/internal/cli"
 	"github.com/g5becks/llxt/internal/cli/commands"
+	"github.com/g5becks/llxt/internal/config"
+	httpclient "github.com/g5becks/llxt/internal/http"
+	"github.com/g5becks/llxt/internal/log"
+	"github.com/g5becks/llxt/internal/registry"
 )
 
 //nolint:gochecknoglobals // version info set by build flags
@@ -24,13 +29,54 @@ func main() {
 		Usage:   "Fetch or generate llms.txt files for AI agents",
 		Version: fmt.Sprintf("%s (commit: %s, built: %s)", version, commit, buildTime),
 		Flags:   llxtcli.GlobalFlags(),
+		Before:  loadRegistryOverlay,
 		Commands: []*cli.Command{
 			commands.FetchCommand(),
+			commands.ListCommand(),
+			commands.SearchCommand(),
+			commands.GenerateCommand(),
+			commands.CacheCommand(),
+			commands.BundleCommand(),
+			commands.AddCommand(),
+			commands.RemoveCommand(),
+			commands.EditCommand(),
 		},
 	}
 
 	if err := cmd.Run(context.Background(), os.Args); err != nil {
-		fmt.Fprintln(os.Stderr, err)
+		log.Error(llxtcli.LoggerFromCommand(cmd), "command failed", err)
 		os.Exit(llxtcli.ExitGeneralError)
 	}
 }
+
+// loadRegistryOverlay merges the user's sources.toml (and, if configured,
+// a shared team index) into the embedded registry before any command runs.
+// A missing or unreachable remote index is logged and otherwise ignored so
+// it never blocks commands that don't need it.
+func loadRegistryOverlay(ctx context.Context, cmd *cli.Command) (context.Context, error) {
+	cfg, err := config.Load(cmd.String("config"))
+	if err != nil {
+		return ctx, llxtcli.ExitError(cmd, err, "Failed to load config file", llxtcli.ExitConfigError)
+	}
+
+	registry.Merge(cfg.Entries())
+
+	if cfg.Registry.Remote == "" {
+		return ctx, nil
+	}
+
+	logger := llxtcli.LoggerFromCommand(cmd)
+	fetcher := httpclient.NewFetcher(httpclient.DefaultConfig(), logger)
+	defer fetcher.Close()
+
+	remoteEntries, err := config.RefreshRemote(ctx, cfg, fetcher, config.RemoteCacheOptions())
+	if err != nil {
+		logger.Warn("failed to refresh remote registry index",
+			slog.String("remote", cfg.Registry.Remote),
+			slog.Any("error", err))
+		return ctx, nil
+	}
+
+	registry.Merge(remoteEntries)
+	return ctx, nil
+}
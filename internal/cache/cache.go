@@ -0,0 +1,157 @@
+// Package cache provides an on-disk cache for fetched llms.txt content,
+// keyed by URL, with ETag/Last-Modified metadata for revalidation.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	errs "github.com/g5becks/llxt/internal/errors"
+)
+
+const dirPerm = 0o755
+
+// Entry is the sidecar record stored for a single cached URL.
+type Entry struct {
+	URL          string    `json:"url"`
+	Body         string    `json:"body"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	FetchedAt    time.Time `json:"fetched_at"`
+}
+
+// Cache stores fetched content under a directory, one JSON file per URL.
+type Cache struct {
+	dir string
+}
+
+// New creates a Cache rooted at dir, creating it if necessary.
+func New(dir string) (*Cache, error) {
+	if err := os.MkdirAll(dir, dirPerm); err != nil {
+		return nil, errs.CacheErr.
+			Code(errs.CodeCacheWrite).
+			With("dir", dir).
+			Wrapf(err, "failed to create cache directory")
+	}
+	return &Cache{dir: dir}, nil
+}
+
+// DefaultDir returns the default cache directory, "llxt" under
+// os.UserCacheDir (~/.cache/llxt on Linux).
+func DefaultDir() (string, error) {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", errs.CacheErr.
+			Code(errs.CodeCacheRead).
+			Wrapf(err, "failed to resolve user cache directory")
+	}
+	return filepath.Join(base, "llxt"), nil
+}
+
+// Get returns the cached entry for url, if present.
+func (c *Cache) Get(url string) (*Entry, bool) {
+	data, err := os.ReadFile(c.path(url))
+	if err != nil {
+		return nil, false
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+
+	return &entry, true
+}
+
+// Put writes entry to disk, overwriting any existing record for its URL.
+func (c *Cache) Put(entry *Entry) error {
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return errs.CacheErr.
+			Code(errs.CodeCacheWrite).
+			With("url", entry.URL).
+			Wrapf(err, "failed to marshal cache entry")
+	}
+
+	if err := os.WriteFile(c.path(entry.URL), data, 0o644); err != nil {
+		return errs.CacheErr.
+			Code(errs.CodeCacheWrite).
+			With("url", entry.URL).
+			Wrapf(err, "failed to write cache entry")
+	}
+
+	return nil
+}
+
+// List returns every cached entry, sorted by URL.
+func (c *Cache) List() ([]*Entry, error) {
+	matches, err := filepath.Glob(filepath.Join(c.dir, "*.json"))
+	if err != nil {
+		return nil, errs.CacheErr.
+			Code(errs.CodeCacheRead).
+			With("dir", c.dir).
+			Wrapf(err, "failed to list cache directory")
+	}
+
+	entries := make([]*Entry, 0, len(matches))
+	for _, m := range matches {
+		data, err := os.ReadFile(m)
+		if err != nil {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			continue
+		}
+		entries = append(entries, &entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].URL < entries[j].URL
+	})
+
+	return entries, nil
+}
+
+// Clear removes every cached entry.
+func (c *Cache) Clear() (int, error) {
+	entries, err := c.List()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, e := range entries {
+		_ = os.Remove(c.path(e.URL))
+	}
+
+	return len(entries), nil
+}
+
+// Prune removes entries older than maxAge and returns how many were removed.
+func (c *Cache) Prune(maxAge time.Duration) (int, error) {
+	entries, err := c.List()
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, e := range entries {
+		if time.Since(e.FetchedAt) > maxAge {
+			if err := os.Remove(c.path(e.URL)); err == nil {
+				removed++
+			}
+		}
+	}
+
+	return removed, nil
+}
+
+func (c *Cache) path(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetPut(t *testing.T) {
+	c, err := New(t.TempDir())
+	if err != nil {
+		t.Fatalf("New() error = %v, want nil", err)
+	}
+
+	if _, ok := c.Get("https://example.com/llms.txt"); ok {
+		t.Fatal("Get() ok = true for an empty cache, want false")
+	}
+
+	entry := &Entry{URL: "https://example.com/llms.txt", Body: "hello", ETag: `"abc"`, FetchedAt: time.Now()}
+	if err := c.Put(entry); err != nil {
+		t.Fatalf("Put() error = %v, want nil", err)
+	}
+
+	got, ok := c.Get(entry.URL)
+	if !ok {
+		t.Fatal("Get() ok = false after Put(), want true")
+	}
+	if got.Body != entry.Body || got.ETag != entry.ETag {
+		t.Fatalf("Get() = %+v, want %+v", got, entry)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	c, err := New(t.TempDir())
+	if err != nil {
+		t.Fatalf("New() error = %v, want nil", err)
+	}
+
+	fresh := &Entry{URL: "https://example.com/fresh.txt", FetchedAt: time.Now()}
+	stale := &Entry{URL: "https://example.com/stale.txt", FetchedAt: time.Now().Add(-48 * time.Hour)}
+
+	if err := c.Put(fresh); err != nil {
+		t.Fatalf("Put(fresh) error = %v, want nil", err)
+	}
+	if err := c.Put(stale); err != nil {
+		t.Fatalf("Put(stale) error = %v, want nil", err)
+	}
+
+	removed, err := c.Prune(24 * time.Hour)
+	if err != nil {
+		t.Fatalf("Prune() error = %v, want nil", err)
+	}
+	if removed != 1 {
+		t.Fatalf("Prune() removed = %d, want 1", removed)
+	}
+
+	if _, ok := c.Get(stale.URL); ok {
+		t.Fatal("Get(stale) ok = true after Prune(), want false")
+	}
+	if _, ok := c.Get(fresh.URL); !ok {
+		t.Fatal("Get(fresh) ok = false after Prune(), want true")
+	}
+}
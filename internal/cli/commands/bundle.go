@@ -0,0 +1,317 @@
+package commands
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/g5becks/llxt/internal/cache"
+	llxtcli "github.com/g5becks/llxt/internal/cli"
+	errs "github.com/g5becks/llxt/internal/errors"
+	httpclient "github.com/g5becks/llxt/internal/http"
+	"github.com/g5becks/llxt/internal/registry"
+	"github.com/g5becks/llxt/internal/ui"
+)
+
+const (
+	archiveTar = "tar"
+	archiveZip = "zip"
+)
+
+// BundleCommand returns the bundle command.
+func BundleCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "bundle",
+		Usage:     "Fetch llms.txt for multiple sources concurrently",
+		ArgsUsage: "<name> [name...]",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "file",
+				Usage: "Read source names from a file, one per line",
+			},
+			&cli.IntFlag{
+				Name:  "concurrency",
+				Usage: "Maximum concurrent fetches (default: GOMAXPROCS)",
+			},
+			&cli.StringFlag{
+				Name:  "archive",
+				Usage: "Emit as an archive instead of markdown: tar|zip",
+			},
+			&cli.BoolFlag{
+				Name:    "full",
+				Aliases: []string{"f"},
+				Usage:   "Fetch llms-full.txt where available",
+			},
+			&cli.BoolFlag{
+				Name:  "no-cache",
+				Usage: "Bypass the on-disk cache entirely",
+			},
+		},
+		Action: bundleAction,
+	}
+}
+
+func bundleAction(ctx context.Context, cmd *cli.Command) error {
+	names, err := bundleNames(cmd)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return cli.Exit("at least one name is required\n\nUsage: llxt bundle <name> [name...]", llxtcli.ExitInvalidInput)
+	}
+
+	targets, err := bundleTargets(cmd, names, cmd.Bool("full"))
+	if err != nil {
+		return err
+	}
+
+	cfg := httpclient.DefaultConfig()
+	cfg.Verbose = cmd.Bool("verbose")
+	fetcher := httpclient.NewFetcher(cfg, llxtcli.LoggerFromCommand(cmd))
+	defer fetcher.Close()
+
+	copts := bundleCacheOptions(cmd)
+
+	concurrency := cmd.Int("concurrency")
+	if concurrency <= 0 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+
+	quiet := cmd.Bool("quiet")
+	var mp *ui.MultiProgress
+	if !quiet {
+		trackerNames := make([]string, 0, len(targets))
+		for _, t := range targets {
+			trackerNames = append(trackerNames, t.Name)
+		}
+		mp = ui.NewMultiProgress(trackerNames)
+	}
+
+	results := make(map[string]httpclient.Result, len(targets))
+	var failures []string
+
+	for res := range fetcher.FetchMany(ctx, targets, concurrency, copts) {
+		results[res.Target.Name] = res
+		if mp != nil {
+			mp.Done(res.Target.Name, res.Err)
+		}
+		if res.Err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", res.Target.Name, res.Err))
+		}
+	}
+
+	if mp != nil {
+		mp.Stop()
+	}
+
+	if len(failures) > 0 {
+		fmt.Fprintln(os.Stderr, "Some sources failed to fetch:")
+		for _, f := range failures {
+			fmt.Fprintf(os.Stderr, "  - %s\n", f)
+		}
+	}
+
+	ordered := make([]httpclient.Result, 0, len(targets))
+	for _, t := range targets {
+		if res, ok := results[t.Name]; ok && res.Err == nil {
+			ordered = append(ordered, res)
+		}
+	}
+
+	return writeBundle(cmd, ordered)
+}
+
+// bundleNames resolves the source names to fetch, from positional args and/or
+// --file.
+func bundleNames(cmd *cli.Command) ([]string, error) {
+	names := append([]string{}, cmd.Args().Slice()...)
+
+	if file := cmd.String("file"); file != "" {
+		fromFile, err := readNamesFile(file)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, fromFile...)
+	}
+
+	return names, nil
+}
+
+func readNamesFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errs.ConfigErr.
+			Code(errs.CodeConfigLoad).
+			With("path", path).
+			Wrapf(err, "failed to open names file")
+	}
+	defer f.Close()
+
+	var names []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		names = append(names, line)
+	}
+
+	return names, nil
+}
+
+func bundleTargets(cmd *cli.Command, names []string, full bool) ([]httpclient.Target, error) {
+	targets := make([]httpclient.Target, 0, len(names))
+	for _, name := range names {
+		entry, err := registry.Lookup(name)
+		if err != nil {
+			return nil, llxtcli.ExitError(cmd, err, fmt.Sprintf("Source %q not found", name), llxtcli.ExitNotFound)
+		}
+		targets = append(targets, httpclient.Target{
+			Name:     entry.Key,
+			URL:      entry.LLMsURL,
+			FullURL:  entry.LLMsFullURL,
+			Full:     full,
+			Transfer: entry.Transfer,
+		})
+	}
+	return targets, nil
+}
+
+func bundleCacheOptions(cmd *cli.Command) httpclient.CacheOptions {
+	if cmd.Bool("no-cache") {
+		return httpclient.CacheOptions{NoCache: true}
+	}
+
+	dir, err := cache.DefaultDir()
+	if err != nil {
+		return httpclient.CacheOptions{NoCache: true}
+	}
+
+	c, err := cache.New(dir)
+	if err != nil {
+		return httpclient.CacheOptions{NoCache: true}
+	}
+
+	return httpclient.CacheOptions{Cache: c}
+}
+
+func writeBundle(cmd *cli.Command, results []httpclient.Result) error {
+	format := cmd.String("archive")
+
+	var (
+		data []byte
+		err  error
+	)
+
+	switch format {
+	case "":
+		data = []byte(buildMarkdownBundle(results))
+	case archiveTar:
+		data, err = buildTarBundle(results)
+	case archiveZip:
+		data, err = buildZipBundle(results)
+	default:
+		return cli.Exit(fmt.Sprintf("unknown archive format %q (want tar|zip)", format), llxtcli.ExitInvalidInput)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	output := cmd.String("output")
+	if output == "" {
+		//nolint:forbidigo // stdout output is intentional for AI/archive consumption
+		os.Stdout.Write(data)
+		return nil
+	}
+
+	if err := os.WriteFile(output, data, 0o644); err != nil {
+		return errs.BundleErr.
+			Code(errs.CodeArchiveFailed).
+			With("output", output).
+			Wrapf(err, "failed to write bundle output")
+	}
+
+	return nil
+}
+
+// buildMarkdownBundle assembles fetched sources into one document: a
+// table-of-contents followed by each source under its own H1.
+func buildMarkdownBundle(results []httpclient.Result) string {
+	var b strings.Builder
+
+	b.WriteString("# Bundle\n\n")
+	for _, r := range results {
+		fmt.Fprintf(&b, "- [%s](#%s)\n", r.Target.Name, r.Target.Name)
+	}
+	b.WriteString("\n")
+
+	for _, r := range results {
+		fmt.Fprintf(&b, "# %s\n\n", r.Target.Name)
+		b.WriteString(r.Content)
+		b.WriteString("\n\n")
+	}
+
+	return b.String()
+}
+
+func buildTarBundle(results []httpclient.Result) ([]byte, error) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+
+	for _, r := range results {
+		name := r.Target.Name + ".txt"
+		if err := tw.WriteHeader(&tar.Header{
+			Name: name,
+			Mode: 0o644,
+			Size: int64(len(r.Content)),
+		}); err != nil {
+			return nil, wrapArchiveErr(err)
+		}
+		if _, err := tw.Write([]byte(r.Content)); err != nil {
+			return nil, wrapArchiveErr(err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, wrapArchiveErr(err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func buildZipBundle(results []httpclient.Result) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	for _, r := range results {
+		w, err := zw.Create(r.Target.Name + ".txt")
+		if err != nil {
+			return nil, wrapArchiveErr(err)
+		}
+		if _, err := w.Write([]byte(r.Content)); err != nil {
+			return nil, wrapArchiveErr(err)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, wrapArchiveErr(err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func wrapArchiveErr(err error) error {
+	return errs.BundleErr.
+		Code(errs.CodeArchiveFailed).
+		Wrapf(err, "failed to build archive")
+}
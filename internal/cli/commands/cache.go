@@ -0,0 +1,125 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/g5becks/llxt/internal/cache"
+	llxtcli "github.com/g5becks/llxt/internal/cli"
+)
+
+const defaultPruneAge = 7 * 24 * time.Hour
+
+// CacheCommand returns the cache management command.
+func CacheCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "cache",
+		Usage: "Manage the on-disk llms.txt cache",
+		Commands: []*cli.Command{
+			cacheClearCommand(),
+			cacheListCommand(),
+			cachePruneCommand(),
+		},
+	}
+}
+
+func cacheClearCommand() *cli.Command {
+	return &cli.Command{
+		Name:   "clear",
+		Usage:  "Remove every cached entry",
+		Action: cacheClearAction,
+	}
+}
+
+func cacheClearAction(_ context.Context, cmd *cli.Command) error {
+	c, err := openCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	removed, err := c.Clear()
+	if err != nil {
+		return llxtcli.ExitError(cmd, err, "Failed to clear cache", llxtcli.ExitConfigError)
+	}
+
+	fmt.Printf("Removed %d cached entries\n", removed)
+	return nil
+}
+
+func cacheListCommand() *cli.Command {
+	return &cli.Command{
+		Name:   "list",
+		Usage:  "List cached entries",
+		Action: cacheListAction,
+	}
+}
+
+func cacheListAction(_ context.Context, cmd *cli.Command) error {
+	c, err := openCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	entries, err := c.List()
+	if err != nil {
+		return llxtcli.ExitError(cmd, err, "Failed to list cache", llxtcli.ExitConfigError)
+	}
+
+	if len(entries) == 0 {
+		fmt.Println("Cache is empty")
+		return nil
+	}
+
+	for _, e := range entries {
+		fmt.Printf("%-60s %8d bytes  fetched %s\n", e.URL, len(e.Body), e.FetchedAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func cachePruneCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "prune",
+		Usage: "Remove cached entries older than --max-age",
+		Flags: []cli.Flag{
+			&cli.DurationFlag{
+				Name:  "max-age",
+				Usage: "Maximum entry age to keep",
+				Value: defaultPruneAge,
+			},
+		},
+		Action: cachePruneAction,
+	}
+}
+
+func cachePruneAction(_ context.Context, cmd *cli.Command) error {
+	c, err := openCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	removed, err := c.Prune(cmd.Duration("max-age"))
+	if err != nil {
+		return llxtcli.ExitError(cmd, err, "Failed to prune cache", llxtcli.ExitConfigError)
+	}
+
+	fmt.Printf("Removed %d stale entries\n", removed)
+	return nil
+}
+
+func openCache(cmd *cli.Command) (*cache.Cache, error) {
+	dir, err := cache.DefaultDir()
+	if err != nil {
+		return nil, llxtcli.ExitError(cmd, err, "Failed to resolve cache directory", llxtcli.ExitConfigError)
+	}
+
+	c, err := cache.New(dir)
+	if err != nil {
+		return nil, llxtcli.ExitError(cmd, err, "Failed to open cache", llxtcli.ExitConfigError)
+	}
+
+	return c, nil
+}
@@ -5,9 +5,9 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/samber/oops"
 	"github.com/urfave/cli/v3"
 
+	"github.com/g5becks/llxt/internal/cache"
 	llxtcli "github.com/g5becks/llxt/internal/cli"
 	httpclient "github.com/g5becks/llxt/internal/http"
 	"github.com/g5becks/llxt/internal/registry"
@@ -26,12 +26,24 @@ func FetchCommand() *cli.Command {
 				Aliases: []string{"f"},
 				Usage:   "Fetch llms-full.txt if available",
 			},
+			&cli.BoolFlag{
+				Name:  "no-cache",
+				Usage: "Bypass the on-disk cache entirely",
+			},
+			&cli.BoolFlag{
+				Name:  "refresh",
+				Usage: "Re-fetch from the origin unconditionally, ignoring --max-age",
+			},
+			&cli.DurationFlag{
+				Name:  "max-age",
+				Usage: "Serve a cached response without revalidation if younger than this",
+			},
 		},
 		Action: fetchAction,
 	}
 }
 
-func fetchAction(_ context.Context, cmd *cli.Command) error {
+func fetchAction(ctx context.Context, cmd *cli.Command) error {
 	name := cmd.Args().First()
 	if name == "" {
 		return cli.Exit("name is required\n\nUsage: llxt fetch <name>", llxtcli.ExitInvalidInput)
@@ -44,21 +56,25 @@ func fetchAction(_ context.Context, cmd *cli.Command) error {
 	// Lookup in registry
 	entry, err := registry.Lookup(name)
 	if err != nil {
-		msg := oops.GetPublic(err, fmt.Sprintf("Source %q not found", name))
-		return cli.Exit(msg, llxtcli.ExitNotFound)
+		return llxtcli.ExitError(cmd, err, fmt.Sprintf("Source %q not found", name), llxtcli.ExitNotFound)
 	}
 
 	// Create HTTP client
 	cfg := httpclient.DefaultConfig()
 	cfg.Verbose = verbose
-	fetcher := httpclient.NewFetcher(cfg)
+	fetcher := httpclient.NewFetcher(cfg, llxtcli.LoggerFromCommand(cmd))
 	defer fetcher.Close()
 
+	copts, err := fetchCacheOptions(cmd)
+	if err != nil {
+		return llxtcli.ExitError(cmd, err, "Failed to open cache", llxtcli.ExitConfigError)
+	}
+
 	var content string
 
 	fetchFn := func() error {
 		var fetchErr error
-		content, fetchErr = fetcher.FetchLLMsTxt(entry.LLMsURL, entry.LLMsFullURL, full)
+		content, fetchErr = fetcher.FetchLLMsTxt(ctx, entry.LLMsURL, entry.LLMsFullURL, full, entry.Transfer, copts)
 		return fetchErr
 	}
 
@@ -69,8 +85,7 @@ func fetchAction(_ context.Context, cmd *cli.Command) error {
 	}
 
 	if err != nil {
-		msg := oops.GetPublic(err, "Failed to fetch llms.txt")
-		return cli.Exit(msg, llxtcli.ExitNetworkError)
+		return llxtcli.ExitError(cmd, err, "Failed to fetch llms.txt", llxtcli.ExitNetworkError)
 	}
 
 	// Output raw content to stdout (AI-friendly)
@@ -78,3 +93,27 @@ func fetchAction(_ context.Context, cmd *cli.Command) error {
 	fmt.Print(content)
 	return nil
 }
+
+// fetchCacheOptions builds httpclient.CacheOptions from the command's cache
+// flags, opening the default on-disk cache unless --no-cache is set.
+func fetchCacheOptions(cmd *cli.Command) (httpclient.CacheOptions, error) {
+	if cmd.Bool("no-cache") {
+		return httpclient.CacheOptions{NoCache: true}, nil
+	}
+
+	dir, err := cache.DefaultDir()
+	if err != nil {
+		return httpclient.CacheOptions{}, err
+	}
+
+	c, err := cache.New(dir)
+	if err != nil {
+		return httpclient.CacheOptions{}, err
+	}
+
+	return httpclient.CacheOptions{
+		Cache:   c,
+		Refresh: cmd.Bool("refresh"),
+		MaxAge:  cmd.Duration("max-age"),
+	}, nil
+}
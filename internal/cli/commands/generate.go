@@ -0,0 +1,176 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+	"resty.dev/v3"
+
+	llxtcli "github.com/g5becks/llxt/internal/cli"
+	errs "github.com/g5becks/llxt/internal/errors"
+	"github.com/g5becks/llxt/internal/generator"
+	ghclient "github.com/g5becks/llxt/internal/github"
+	httpclient "github.com/g5becks/llxt/internal/http"
+	"github.com/g5becks/llxt/internal/ui"
+)
+
+const defaultMaxPages = 100
+
+// GenerateCommand returns the generate command.
+func GenerateCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "generate",
+		Usage: "Generate an llms.txt by crawling a site or indexing a GitHub repo",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "url",
+				Usage: "Crawl a website starting at this URL",
+			},
+			&cli.StringFlag{
+				Name:  "repo",
+				Usage: "Index a GitHub repository, as owner/name",
+			},
+			&cli.IntFlag{
+				Name:  "max-pages",
+				Usage: "Maximum number of pages or files to index",
+				Value: defaultMaxPages,
+			},
+			&cli.StringSliceFlag{
+				Name:  "include",
+				Usage: "Glob patterns to include (repeatable)",
+			},
+			&cli.StringSliceFlag{
+				Name:  "exclude",
+				Usage: "Glob patterns to exclude (repeatable)",
+			},
+		},
+		Action: generateAction,
+	}
+}
+
+func generateAction(ctx context.Context, cmd *cli.Command) error {
+	url := cmd.String("url")
+	repo := cmd.String("repo")
+
+	switch {
+	case url != "" && repo != "":
+		return cli.Exit("only one of --url or --repo may be given", llxtcli.ExitInvalidInput)
+	case url == "" && repo == "":
+		return cli.Exit("one of --url or --repo is required", llxtcli.ExitInvalidInput)
+	}
+
+	opts := generator.Options{
+		MaxPages: cmd.Int("max-pages"),
+		Include:  cmd.StringSlice("include"),
+		Exclude:  cmd.StringSlice("exclude"),
+	}
+
+	quiet := cmd.Bool("quiet")
+	verbose := cmd.Bool("verbose")
+	logger := llxtcli.LoggerFromCommand(cmd)
+
+	cfg := httpclient.DefaultConfig()
+	cfg.Verbose = verbose
+	client := httpclient.NewClient(cfg, logger)
+
+	var (
+		doc string
+		err error
+	)
+
+	if url != "" {
+		doc, err = generateFromURL(ctx, client, url, opts, logger, quiet)
+	} else {
+		doc, err = generateFromRepo(ctx, client, repo, opts, logger, quiet)
+	}
+
+	if err != nil {
+		return llxtcli.ExitError(cmd, err, "Failed to generate llms.txt", llxtcli.ExitNetworkError)
+	}
+
+	return writeGenerated(cmd, doc)
+}
+
+func generateFromURL(ctx context.Context, client *resty.Client, url string, opts generator.Options, logger *slog.Logger, quiet bool) (string, error) {
+	var pages []generator.Page
+
+	crawlFn := func(increment, setTotal func(n int64)) error {
+		var err error
+		pages, err = generator.Crawl(ctx, client, url, opts, logger, func(_, total int) {
+			setTotal(int64(total))
+			increment(1)
+		})
+		return err
+	}
+
+	var err error
+	if quiet {
+		err = crawlFn(func(int64) {}, func(int64) {})
+	} else {
+		err = ui.WithProgressBar(fmt.Sprintf("Crawling %s...", url), int64(opts.MaxPages), crawlFn)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return generator.BuildSiteDoc(url, pages), nil
+}
+
+func generateFromRepo(ctx context.Context, client *resty.Client, repo string, opts generator.Options, logger *slog.Logger, quiet bool) (string, error) {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok {
+		return "", errs.GeneratorErr.
+			Code(errs.CodeInvalidURL).
+			With("repo", repo).
+			Errorf("repo must be in owner/name form, got %q", repo)
+	}
+
+	gh := ghclient.NewClient(client, logger)
+
+	var docs []generator.Doc
+
+	indexFn := func(increment, setTotal func(n int64)) error {
+		var err error
+		docs, err = generator.IndexRepo(ctx, gh, owner, name, opts, func(_, total int) {
+			setTotal(int64(total))
+			increment(1)
+		})
+		return err
+	}
+
+	var err error
+	if quiet {
+		err = indexFn(func(int64) {}, func(int64) {})
+	} else {
+		err = ui.WithProgressBar(fmt.Sprintf("Indexing %s...", repo), int64(opts.MaxPages), indexFn)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return generator.BuildRepoDoc(owner, name, docs), nil
+}
+
+func writeGenerated(cmd *cli.Command, doc string) error {
+	output := cmd.String("output")
+	if output == "" {
+		//nolint:forbidigo // stdout output is intentional for AI consumption
+		fmt.Print(doc)
+		return nil
+	}
+
+	if err := os.WriteFile(output, []byte(doc), 0o644); err != nil {
+		return errs.GeneratorErr.
+			Code(errs.CodeCrawlFailed).
+			With("output", output).
+			Wrapf(err, "failed to write output file")
+	}
+
+	return nil
+}
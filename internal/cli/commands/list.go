@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/urfave/cli/v3"
+
+	"github.com/g5becks/llxt/internal/registry"
+)
+
+const (
+	formatTable = "table"
+	formatTSV   = "tsv"
+)
+
+// ListCommand returns the list command.
+func ListCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "list",
+		Usage: "List available llms.txt sources",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "category",
+				Usage: "Filter results to a single category",
+			},
+			&cli.BoolFlag{
+				Name:  "json",
+				Usage: "Output as JSON",
+			},
+			&cli.StringFlag{
+				Name:  "format",
+				Usage: "Output format: table|tsv",
+				Value: formatTable,
+			},
+		},
+		Action: listAction,
+	}
+}
+
+func listAction(_ context.Context, cmd *cli.Command) error {
+	entries := entriesForCategory(cmd.String("category"))
+
+	if cmd.Bool("json") {
+		return printEntriesJSON(entries)
+	}
+
+	switch cmd.String("format") {
+	case formatTSV:
+		printEntriesTSV(entries)
+	default:
+		printEntriesTable(entries)
+	}
+
+	return nil
+}
+
+func entriesForCategory(category string) []*registry.Entry {
+	if category == "" {
+		return registry.List()
+	}
+	return registry.ListByCategory(category)
+}
+
+func printEntriesJSON(entries []*registry.Entry) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(entries)
+}
+
+func printEntriesTable(entries []*registry.Entry) {
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.AppendHeader(table.Row{"Key", "Name", "Category", "Domain"})
+	for _, e := range entries {
+		t.AppendRow(table.Row{e.Key, e.Name, e.Category, e.Domain})
+	}
+	t.Render()
+}
+
+func printEntriesTSV(entries []*registry.Entry) {
+	for _, e := range entries {
+		fmt.Printf("%s\t%s\t%s\t%s\n", e.Key, e.Name, e.Category, e.Domain)
+	}
+}
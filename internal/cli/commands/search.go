@@ -0,0 +1,166 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	llxtcli "github.com/g5becks/llxt/internal/cli"
+	"github.com/g5becks/llxt/internal/registry"
+)
+
+const (
+	scoreExactKey    = 1000
+	scoreSubstrKey   = 500
+	scoreSubstrName  = 300
+	scoreSubstrDesc  = 100
+	fuzzyMaxDistance = 3
+)
+
+type searchResult struct {
+	entry *registry.Entry
+	score int
+}
+
+// SearchCommand returns the search command.
+func SearchCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "search",
+		Usage:     "Search llms.txt sources by name, key, or description",
+		ArgsUsage: "<query>",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "category",
+				Usage: "Filter results to a single category",
+			},
+			&cli.BoolFlag{
+				Name:  "json",
+				Usage: "Output as JSON",
+			},
+		},
+		Action: searchAction,
+	}
+}
+
+func searchAction(_ context.Context, cmd *cli.Command) error {
+	query := cmd.Args().First()
+	if query == "" {
+		return cli.Exit("query is required\n\nUsage: llxt search <query>", llxtcli.ExitInvalidInput)
+	}
+
+	entries := entriesForCategory(cmd.String("category"))
+	results := rankEntries(entries, query)
+
+	if cmd.Bool("json") {
+		matched := make([]*registry.Entry, 0, len(results))
+		for _, r := range results {
+			matched = append(matched, r.entry)
+		}
+		return printEntriesJSON(matched)
+	}
+
+	if len(results) == 0 {
+		fmt.Printf("No matches for %q\n", query)
+		return nil
+	}
+
+	for _, r := range results {
+		fmt.Printf("%-20s %-30s %s\n", r.entry.Key, r.entry.Name, r.entry.Description)
+	}
+
+	return nil
+}
+
+// rankEntries scores every entry against query and returns matches sorted
+// best-first. Matching is case-insensitive substring over key/name/description,
+// falling back to a Levenshtein distance against key/name for typo tolerance.
+func rankEntries(entries []*registry.Entry, query string) []searchResult {
+	q := strings.ToLower(query)
+	results := make([]searchResult, 0, len(entries))
+
+	for _, e := range entries {
+		if score, ok := matchScore(e, q); ok {
+			results = append(results, searchResult{entry: e, score: score})
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].score > results[j].score
+	})
+
+	return results
+}
+
+func matchScore(e *registry.Entry, q string) (int, bool) {
+	key := strings.ToLower(e.Key)
+	name := strings.ToLower(e.Name)
+	desc := strings.ToLower(e.Description)
+
+	switch {
+	case key == q:
+		return scoreExactKey, true
+	case strings.Contains(key, q):
+		return scoreSubstrKey, true
+	case strings.Contains(name, q):
+		return scoreSubstrName, true
+	case strings.Contains(desc, q):
+		return scoreSubstrDesc, true
+	}
+
+	if d := levenshtein(q, key); d <= fuzzyMaxDistance {
+		return fuzzyMaxDistance - d, true
+	}
+	if d := levenshtein(q, name); d <= fuzzyMaxDistance {
+		return fuzzyMaxDistance - d, true
+	}
+
+	return 0, false
+}
+
+// levenshtein computes the edit distance between two strings.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	la, lb := len(ra), len(rb)
+
+	if la == 0 {
+		return lb
+	}
+	if lb == 0 {
+		return la
+	}
+
+	prev := make([]int, lb+1)
+	curr := make([]int, lb+1)
+
+	for j := 0; j <= lb; j++ {
+		prev[j] = j
+	}
+
+	for i := 1; i <= la; i++ {
+		curr[0] = i
+		for j := 1; j <= lb; j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[lb]
+}
+
+func min3(a, b, c int) int {
+	m := a
+	if b < m {
+		m = b
+	}
+	if c < m {
+		m = c
+	}
+	return m
+}
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/g5becks/llxt/internal/registry"
+)
+
+func TestMatchScore(t *testing.T) {
+	entry := &registry.Entry{
+		Key:         "reactjs",
+		Name:        "React Framework",
+		Description: "A JavaScript library for building user interfaces",
+	}
+
+	tests := []struct {
+		name      string
+		query     string
+		wantScore int
+		wantOK    bool
+	}{
+		{name: "exact key match", query: "reactjs", wantScore: scoreExactKey, wantOK: true},
+		{name: "substring key match", query: "react", wantScore: scoreSubstrKey, wantOK: true},
+		{name: "substring name match", query: "framework", wantScore: scoreSubstrName, wantOK: true},
+		{name: "substring description match", query: "javascript", wantScore: scoreSubstrDesc, wantOK: true},
+		{name: "fuzzy key match", query: "reactjz", wantScore: fuzzyMaxDistance - 1, wantOK: true},
+		{name: "no match", query: "zzzzzzzzzz", wantScore: 0, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, ok := matchScore(entry, tt.query)
+			if ok != tt.wantOK {
+				t.Fatalf("matchScore(%q) ok = %v, want %v", tt.query, ok, tt.wantOK)
+			}
+			if ok && score != tt.wantScore {
+				t.Fatalf("matchScore(%q) score = %d, want %d", tt.query, score, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestRankEntries(t *testing.T) {
+	entries := []*registry.Entry{
+		{Key: "vue", Name: "Vue"},
+		{Key: "react", Name: "React"},
+		{Key: "preact", Name: "Preact"},
+		{Key: "svelte", Name: "Svelte"},
+	}
+
+	results := rankEntries(entries, "react")
+
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	if results[0].entry.Key != "react" {
+		t.Fatalf("best match = %q, want %q", results[0].entry.Key, "react")
+	}
+	if results[0].score <= results[1].score {
+		t.Fatalf("results not sorted best-first: %+v", results)
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "", b: "", want: 0},
+		{a: "", b: "abc", want: 3},
+		{a: "abc", b: "", want: 3},
+		{a: "react", b: "react", want: 0},
+		{a: "react", b: "reakt", want: 1},
+		{a: "kitten", b: "sitting", want: 3},
+	}
+
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/urfave/cli/v3"
+
+	llxtcli "github.com/g5becks/llxt/internal/cli"
+	"github.com/g5becks/llxt/internal/config"
+	"github.com/g5becks/llxt/internal/registry"
+)
+
+var sourceFlags = []cli.Flag{
+	&cli.StringFlag{Name: "name", Usage: "Display name"},
+	&cli.StringFlag{Name: "domain", Usage: "Source domain"},
+	&cli.StringFlag{Name: "llms-url", Usage: "URL of llms.txt"},
+	&cli.StringFlag{Name: "llms-full-url", Usage: "URL of llms-full.txt, if available"},
+	&cli.StringFlag{Name: "category", Usage: "Category"},
+	&cli.StringFlag{Name: "description", Usage: "Short description"},
+}
+
+// AddCommand returns the command that adds a new source to sources.toml.
+func AddCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "add",
+		Usage:     "Add a source to the local sources.toml overlay",
+		ArgsUsage: "<key>",
+		Flags:     sourceFlags,
+		Action:    addAction,
+	}
+}
+
+// RemoveCommand returns the command that removes a source from
+// sources.toml.
+func RemoveCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "remove",
+		Usage:     "Remove a source from the local sources.toml overlay",
+		ArgsUsage: "<key>",
+		Action:    removeAction,
+	}
+}
+
+// EditCommand returns the command that edits a source in sources.toml,
+// overriding an embedded entry if key isn't already in the overlay.
+func EditCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "edit",
+		Usage:     "Edit a source, overriding the embedded registry if needed",
+		ArgsUsage: "<key>",
+		Flags:     sourceFlags,
+		Action:    editAction,
+	}
+}
+
+func addAction(_ context.Context, cmd *cli.Command) error {
+	key := cmd.Args().First()
+	if key == "" {
+		return cli.Exit("key is required\n\nUsage: llxt add <key> --name <name> --domain <domain> --llms-url <url>", llxtcli.ExitInvalidInput)
+	}
+
+	if cmd.String("name") == "" || cmd.String("domain") == "" || cmd.String("llms-url") == "" {
+		return cli.Exit("--name, --domain, and --llms-url are required", llxtcli.ExitInvalidInput)
+	}
+
+	cfg, err := loadSourcesConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	source := sourceFromFlags(cmd, key, config.Source{})
+	if err := cfg.AddSource(source); err != nil {
+		return llxtcli.ExitError(cmd, err, fmt.Sprintf("Failed to add %q", key), llxtcli.ExitConfigError)
+	}
+
+	if err := saveSourcesConfig(cmd, cfg); err != nil {
+		return err
+	}
+
+	fmt.Printf("Added %q to %s\n", key, cmd.String("config"))
+	return nil
+}
+
+func removeAction(_ context.Context, cmd *cli.Command) error {
+	key := cmd.Args().First()
+	if key == "" {
+		return cli.Exit("key is required\n\nUsage: llxt remove <key>", llxtcli.ExitInvalidInput)
+	}
+
+	cfg, err := loadSourcesConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	if !cfg.RemoveSource(key) {
+		return cli.Exit(fmt.Sprintf("%q is not in the local sources.toml overlay", key), llxtcli.ExitNotFound)
+	}
+
+	if err := saveSourcesConfig(cmd, cfg); err != nil {
+		return err
+	}
+
+	fmt.Printf("Removed %q from %s\n", key, cmd.String("config"))
+	return nil
+}
+
+func editAction(_ context.Context, cmd *cli.Command) error {
+	key := cmd.Args().First()
+	if key == "" {
+		return cli.Exit("key is required\n\nUsage: llxt edit <key> [flags]", llxtcli.ExitInvalidInput)
+	}
+
+	cfg, err := loadSourcesConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	base, err := baseSource(cmd, cfg, key)
+	if err != nil {
+		return err
+	}
+
+	updated := sourceFromFlags(cmd, key, base)
+
+	edited, err := cfg.EditSource(key, updated)
+	if err != nil {
+		return llxtcli.ExitError(cmd, err, fmt.Sprintf("Failed to edit %q", key), llxtcli.ExitConfigError)
+	}
+	if !edited {
+		if err := cfg.AddSource(updated); err != nil {
+			return llxtcli.ExitError(cmd, err, fmt.Sprintf("Failed to edit %q", key), llxtcli.ExitConfigError)
+		}
+	}
+
+	if err := saveSourcesConfig(cmd, cfg); err != nil {
+		return err
+	}
+
+	fmt.Printf("Saved %q to %s\n", key, cmd.String("config"))
+	return nil
+}
+
+// baseSource returns the starting point for an edit: the existing overlay
+// entry for key if there is one, otherwise the embedded registry entry so
+// editing one field doesn't drop the others.
+func baseSource(cmd *cli.Command, cfg *config.Config, key string) (config.Source, error) {
+	if i := cfg.IndexOf(key); i >= 0 {
+		return cfg.Sources[i], nil
+	}
+
+	entry, err := registry.Lookup(key)
+	if err != nil {
+		return config.Source{}, llxtcli.ExitError(cmd, err, fmt.Sprintf("Source %q not found", key), llxtcli.ExitNotFound)
+	}
+
+	return config.Source{
+		Key:         entry.Key,
+		Name:        entry.Name,
+		Domain:      entry.Domain,
+		LLMsURL:     entry.LLMsURL,
+		LLMsFullURL: entry.LLMsFullURL,
+		Category:    entry.Category,
+		Description: entry.Description,
+	}, nil
+}
+
+// sourceFromFlags overlays any --name/--domain/... flags the user passed
+// onto base, leaving fields base already had where a flag wasn't given.
+func sourceFromFlags(cmd *cli.Command, key string, base config.Source) config.Source {
+	s := base
+	s.Key = key
+
+	if v := cmd.String("name"); v != "" {
+		s.Name = v
+	}
+	if v := cmd.String("domain"); v != "" {
+		s.Domain = v
+	}
+	if v := cmd.String("llms-url"); v != "" {
+		s.LLMsURL = v
+	}
+	if v := cmd.String("llms-full-url"); v != "" {
+		s.LLMsFullURL = &v
+	}
+	if v := cmd.String("category"); v != "" {
+		s.Category = v
+	}
+	if v := cmd.String("description"); v != "" {
+		s.Description = v
+	}
+
+	return s
+}
+
+func loadSourcesConfig(cmd *cli.Command) (*config.Config, error) {
+	cfg, err := config.Load(cmd.String("config"))
+	if err != nil {
+		return nil, llxtcli.ExitError(cmd, err, "Failed to load config file", llxtcli.ExitConfigError)
+	}
+	return cfg, nil
+}
+
+func saveSourcesConfig(cmd *cli.Command, cfg *config.Config) error {
+	if err := config.Save(cmd.String("config"), cfg); err != nil {
+		return llxtcli.ExitError(cmd, err, "Failed to write config file", llxtcli.ExitConfigError)
+	}
+	return nil
+}
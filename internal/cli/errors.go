@@ -0,0 +1,19 @@
+package cli
+
+import (
+	"github.com/samber/oops"
+	"github.com/urfave/cli/v3"
+
+	"github.com/g5becks/llxt/internal/log"
+)
+
+// ExitError logs err at error level through cmd's configured logger,
+// preserving its domain/code/context for structured output, then returns a
+// cli.Exit carrying err's public message (falling back to fallback) and
+// code. Command actions should use this instead of oops.GetPublic plus a
+// bare cli.Exit so a failure is never silently dropped from --log-format=json
+// output.
+func ExitError(cmd *cli.Command, err error, fallback string, code int) error {
+	log.Error(LoggerFromCommand(cmd), fallback, err)
+	return cli.Exit(oops.GetPublic(err, fallback), code)
+}
@@ -41,5 +41,16 @@ func GlobalFlags() []cli.Flag {
 			Aliases: []string{"q"},
 			Usage:   "Suppress progress spinners",
 		},
+		&cli.StringFlag{
+			Name:    "log-level",
+			Usage:   "Log level: trace|debug|info|warn|error",
+			Sources: cli.EnvVars("LLXT_LOG_LEVEL"),
+		},
+		&cli.StringFlag{
+			Name:    "log-format",
+			Usage:   "Log output format: text|json",
+			Value:   "text",
+			Sources: cli.EnvVars("LLXT_LOG_FORMAT"),
+		},
 	}
 }
@@ -0,0 +1,20 @@
+package cli
+
+import (
+	"log/slog"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/g5becks/llxt/internal/log"
+)
+
+// LoggerFromCommand builds a logger from the global --verbose/--quiet/
+// --log-level/--log-format flags, wherever in the command tree cmd sits.
+func LoggerFromCommand(cmd *cli.Command) *slog.Logger {
+	return log.New(log.Options{
+		Verbose: cmd.Bool("verbose"),
+		Quiet:   cmd.Bool("quiet"),
+		Level:   cmd.String("log-level"),
+		Format:  cmd.String("log-format"),
+	})
+}
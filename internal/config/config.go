@@ -0,0 +1,237 @@
+// Package config loads the user-editable sources.toml overlay that extends
+// the embedded registry, along with the [registry] remote index setting
+// used to refresh a shared team registry.
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+
+	errs "github.com/g5becks/llxt/internal/errors"
+)
+
+const (
+	dirPerm  = 0o755
+	filePerm = 0o644
+)
+
+// Source is a user-defined registry entry, one per [[source]] table in
+// sources.toml.
+type Source struct {
+	Key         string  `toml:"key"`
+	Name        string  `toml:"name"`
+	Domain      string  `toml:"domain"`
+	LLMsURL     string  `toml:"llms_url"`
+	LLMsFullURL *string `toml:"llms_full_url,omitempty"`
+	Category    string  `toml:"category,omitempty"`
+	Description string  `toml:"description,omitempty"`
+}
+
+// RemoteConfig configures a shared team index to merge in alongside local
+// sources.
+type RemoteConfig struct {
+	// Remote is the URL of a JSON document shaped like the registry's own
+	// entries, refreshed and cached like any other fetch.
+	Remote string `toml:"remote,omitempty"`
+}
+
+// Config is the parsed contents of sources.toml.
+type Config struct {
+	Sources  []Source     `toml:"source"`
+	Registry RemoteConfig `toml:"registry"`
+}
+
+// Load reads and validates the config file at path. A missing file is not
+// an error: it returns an empty Config so the embedded registry is used
+// as-is. path may start with "~" for the user's home directory.
+func Load(path string) (*Config, error) {
+	resolved, err := expandHome(path)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(resolved)
+	if os.IsNotExist(err) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, errs.ConfigErr.
+			Code(errs.CodeConfigLoad).
+			With("path", resolved).
+			Wrapf(err, "failed to read config file")
+	}
+
+	var cfg Config
+	if _, err := toml.Decode(string(data), &cfg); err != nil {
+		return nil, errs.ConfigErr.
+			Code(errs.CodeConfigParse).
+			With("path", resolved).
+			Wrapf(err, "failed to parse config file")
+	}
+
+	if err := validate(&cfg); err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
+
+// Save atomically writes cfg to path: it encodes to a temp file in the same
+// directory, backs up any existing file to path+".bak", then renames the
+// temp file into place.
+func Save(path string, cfg *Config) error {
+	resolved, err := expandHome(path)
+	if err != nil {
+		return err
+	}
+
+	if err := validate(cfg); err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(resolved)
+	if err := os.MkdirAll(dir, dirPerm); err != nil {
+		return errs.ConfigErr.
+			Code(errs.CodeConfigWrite).
+			With("path", resolved).
+			Wrapf(err, "failed to create config directory")
+	}
+
+	var buf strings.Builder
+	if err := toml.NewEncoder(&buf).Encode(cfg); err != nil {
+		return errs.ConfigErr.
+			Code(errs.CodeConfigWrite).
+			With("path", resolved).
+			Wrapf(err, "failed to encode config file")
+	}
+
+	if _, err := os.Stat(resolved); err == nil {
+		if err := backup(resolved); err != nil {
+			return err
+		}
+	}
+
+	return writeAtomic(dir, resolved, []byte(buf.String()))
+}
+
+func writeAtomic(dir, path string, data []byte) error {
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return errs.ConfigErr.
+			Code(errs.CodeConfigWrite).
+			With("path", path).
+			Wrapf(err, "failed to create temp file")
+	}
+	tmpPath := tmp.Name()
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return errs.ConfigErr.
+			Code(errs.CodeConfigWrite).
+			With("path", path).
+			Wrapf(err, "failed to write temp file")
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpPath)
+		return errs.ConfigErr.
+			Code(errs.CodeConfigWrite).
+			With("path", path).
+			Wrapf(err, "failed to close temp file")
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return errs.ConfigErr.
+			Code(errs.CodeConfigWrite).
+			With("path", path).
+			Wrapf(err, "failed to move temp file into place")
+	}
+
+	return nil
+}
+
+func backup(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return errs.ConfigErr.
+			Code(errs.CodeConfigLoad).
+			With("path", path).
+			Wrapf(err, "failed to read config file for backup")
+	}
+
+	if err := os.WriteFile(path+".bak", data, filePerm); err != nil {
+		return errs.ConfigErr.
+			Code(errs.CodeConfigWrite).
+			With("path", path).
+			Wrapf(err, "failed to write config backup")
+	}
+
+	return nil
+}
+
+func validate(cfg *Config) error {
+	seen := make(map[string]bool, len(cfg.Sources))
+
+	for _, s := range cfg.Sources {
+		if err := validateSource(s); err != nil {
+			return err
+		}
+
+		key := strings.ToLower(s.Key)
+		if seen[key] {
+			return errs.ConfigErr.
+				Code(errs.CodeConfigParse).
+				With("key", s.Key).
+				Errorf("duplicate source key %q", s.Key)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+func validateSource(s Source) error {
+	switch {
+	case s.Key == "":
+		return errs.ConfigErr.
+			Code(errs.CodeConfigParse).
+			Errorf("source entry missing required field %q", "key")
+	case s.Name == "":
+		return errs.ConfigErr.
+			Code(errs.CodeConfigParse).
+			With("key", s.Key).
+			Errorf("source %q missing required field %q", s.Key, "name")
+	case s.Domain == "":
+		return errs.ConfigErr.
+			Code(errs.CodeConfigParse).
+			With("key", s.Key).
+			Errorf("source %q missing required field %q", s.Key, "domain")
+	case s.LLMsURL == "":
+		return errs.ConfigErr.
+			Code(errs.CodeConfigParse).
+			With("key", s.Key).
+			Errorf("source %q missing required field %q", s.Key, "llms_url")
+	default:
+		return nil
+	}
+}
+
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", errs.ConfigErr.
+			Code(errs.CodeConfigLoad).
+			Wrapf(err, "failed to resolve home directory")
+	}
+
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
@@ -0,0 +1,127 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestAddSource(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial []Source
+		add     Source
+		wantErr bool
+	}{
+		{
+			name: "adds a new source",
+			add:  Source{Key: "react", Name: "React", Domain: "react.dev", LLMsURL: "https://react.dev/llms.txt"},
+		},
+		{
+			name:    "rejects a duplicate key",
+			initial: []Source{{Key: "react", Name: "React", Domain: "react.dev", LLMsURL: "https://react.dev/llms.txt"}},
+			add:     Source{Key: "React", Name: "React again", Domain: "react.dev", LLMsURL: "https://react.dev/llms.txt"},
+			wantErr: true,
+		},
+		{
+			name:    "rejects a source missing required fields",
+			add:     Source{Key: "vue"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Sources: append([]Source{}, tt.initial...)}
+
+			err := cfg.AddSource(tt.add)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("AddSource() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AddSource() error = %v, want nil", err)
+			}
+			if cfg.IndexOf(tt.add.Key) < 0 {
+				t.Fatalf("AddSource() did not add %q", tt.add.Key)
+			}
+		})
+	}
+}
+
+func TestEditSource(t *testing.T) {
+	cfg := &Config{Sources: []Source{
+		{Key: "react", Name: "React", Domain: "react.dev", LLMsURL: "https://react.dev/llms.txt"},
+	}}
+
+	edited, err := cfg.EditSource("React", Source{Key: "react", Name: "React (updated)", Domain: "react.dev", LLMsURL: "https://react.dev/llms.txt"})
+	if err != nil {
+		t.Fatalf("EditSource() error = %v, want nil", err)
+	}
+	if !edited {
+		t.Fatal("EditSource() edited = false, want true")
+	}
+	if cfg.Sources[0].Name != "React (updated)" {
+		t.Fatalf("EditSource() did not update name, got %q", cfg.Sources[0].Name)
+	}
+
+	edited, err = cfg.EditSource("vue", Source{Key: "vue", Name: "Vue", Domain: "vuejs.org", LLMsURL: "https://vuejs.org/llms.txt"})
+	if err != nil {
+		t.Fatalf("EditSource() error = %v, want nil", err)
+	}
+	if edited {
+		t.Fatal("EditSource() edited = true for a key that isn't present, want false")
+	}
+
+	_, err = cfg.EditSource("react", Source{Key: "react"})
+	if err == nil {
+		t.Fatal("EditSource() error = nil for an invalid replacement, want error")
+	}
+}
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "sources.toml")
+
+	cfg := &Config{
+		Sources: []Source{
+			{Key: "react", Name: "React", Domain: "react.dev", LLMsURL: "https://react.dev/llms.txt"},
+		},
+		Registry: RemoteConfig{Remote: "https://example.com/registry.json"},
+	}
+
+	if err := Save(path, cfg); err != nil {
+		t.Fatalf("Save() error = %v, want nil", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v, want nil", err)
+	}
+
+	if len(loaded.Sources) != 1 || loaded.Sources[0].Key != "react" {
+		t.Fatalf("Load() sources = %+v, want one source with key %q", loaded.Sources, "react")
+	}
+	if loaded.Registry.Remote != cfg.Registry.Remote {
+		t.Fatalf("Load() remote = %q, want %q", loaded.Registry.Remote, cfg.Registry.Remote)
+	}
+
+	// A second save backs up the previous file instead of losing it.
+	if err := Save(path, cfg); err != nil {
+		t.Fatalf("Save() second call error = %v, want nil", err)
+	}
+	if _, err := Load(path + ".bak"); err != nil {
+		t.Fatalf("Load() backup file error = %v, want nil", err)
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	cfg, err := Load(filepath.Join(t.TempDir(), "does-not-exist.toml"))
+	if err != nil {
+		t.Fatalf("Load() error = %v, want nil for a missing file", err)
+	}
+	if len(cfg.Sources) != 0 {
+		t.Fatalf("Load() sources = %+v, want empty for a missing file", cfg.Sources)
+	}
+}
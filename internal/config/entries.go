@@ -0,0 +1,83 @@
+package config
+
+import (
+	"strings"
+
+	errs "github.com/g5becks/llxt/internal/errors"
+	"github.com/g5becks/llxt/internal/registry"
+)
+
+// Entries converts cfg's sources into registry.Entry values suitable for
+// merging into the embedded registry.
+func (cfg *Config) Entries() []*registry.Entry {
+	out := make([]*registry.Entry, 0, len(cfg.Sources))
+	for i := range cfg.Sources {
+		out = append(out, cfg.Sources[i].toEntry())
+	}
+	return out
+}
+
+func (s Source) toEntry() *registry.Entry {
+	return &registry.Entry{
+		Key:         s.Key,
+		Name:        s.Name,
+		Domain:      s.Domain,
+		Description: s.Description,
+		Category:    s.Category,
+		LLMsURL:     s.LLMsURL,
+		LLMsFullURL: s.LLMsFullURL,
+	}
+}
+
+// IndexOf returns the position of key in cfg.Sources (case-insensitive), or
+// -1 if it isn't present.
+func (cfg *Config) IndexOf(key string) int {
+	k := strings.ToLower(key)
+	for i, s := range cfg.Sources {
+		if strings.ToLower(s.Key) == k {
+			return i
+		}
+	}
+	return -1
+}
+
+// AddSource appends s to cfg.Sources. It errors if key already exists so
+// callers use EditSource to change an existing entry.
+func (cfg *Config) AddSource(s Source) error {
+	if cfg.IndexOf(s.Key) >= 0 {
+		return errs.ConfigErr.
+			Code(errs.CodeConfigParse).
+			With("key", s.Key).
+			Errorf("source %q already exists; use edit to change it", s.Key)
+	}
+	if err := validateSource(s); err != nil {
+		return err
+	}
+	cfg.Sources = append(cfg.Sources, s)
+	return nil
+}
+
+// RemoveSource deletes the source named key, reporting whether it was
+// present.
+func (cfg *Config) RemoveSource(key string) bool {
+	i := cfg.IndexOf(key)
+	if i < 0 {
+		return false
+	}
+	cfg.Sources = append(cfg.Sources[:i], cfg.Sources[i+1:]...)
+	return true
+}
+
+// EditSource replaces the source named key with updated, reporting whether
+// key was present.
+func (cfg *Config) EditSource(key string, updated Source) (bool, error) {
+	i := cfg.IndexOf(key)
+	if i < 0 {
+		return false, nil
+	}
+	if err := validateSource(updated); err != nil {
+		return false, err
+	}
+	cfg.Sources[i] = updated
+	return true, nil
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/g5becks/llxt/internal/cache"
+	errs "github.com/g5becks/llxt/internal/errors"
+	httpclient "github.com/g5becks/llxt/internal/http"
+	"github.com/g5becks/llxt/internal/registry"
+)
+
+// remoteMaxAge bounds how often the remote index is revalidated; "periodic"
+// refresh is just CacheOptions.MaxAge applied to an ordinary fetch.
+const remoteMaxAge = time.Hour
+
+// RemoteCacheOptions returns the cache options RefreshRemote should fetch
+// with: revalidate at most once per remoteMaxAge, falling back to no
+// caching if the on-disk cache can't be opened.
+func RemoteCacheOptions() httpclient.CacheOptions {
+	dir, err := cache.DefaultDir()
+	if err != nil {
+		return httpclient.CacheOptions{NoCache: true}
+	}
+
+	c, err := cache.New(dir)
+	if err != nil {
+		return httpclient.CacheOptions{NoCache: true}
+	}
+
+	return httpclient.CacheOptions{Cache: c, MaxAge: remoteMaxAge}
+}
+
+// RefreshRemote fetches the [registry] remote index configured in cfg and
+// returns its entries for merging into the registry. It returns nil, nil
+// when no remote is configured. The fetch goes through fetcher so it is
+// cached and revalidated like any other source.
+func RefreshRemote(ctx context.Context, cfg *Config, fetcher *httpclient.Fetcher, copts httpclient.CacheOptions) ([]*registry.Entry, error) {
+	if cfg.Registry.Remote == "" {
+		return nil, nil
+	}
+
+	body, err := fetcher.FetchLLMsTxt(ctx, cfg.Registry.Remote, nil, false, "", copts)
+	if err != nil {
+		return nil, err
+	}
+
+	var remote []registry.Entry
+	if err := json.Unmarshal([]byte(body), &remote); err != nil {
+		return nil, errs.ConfigErr.
+			Code(errs.CodeConfigParse).
+			With("remote", cfg.Registry.Remote).
+			Wrapf(err, "failed to parse remote registry index")
+	}
+
+	out := make([]*registry.Entry, len(remote))
+	for i := range remote {
+		out[i] = &remote[i]
+	}
+
+	return out, nil
+}
@@ -26,4 +26,28 @@ const (
 	CodePathNotFound = "path_not_found"
 	// CodeAPIError indicates a GitHub API error occurred.
 	CodeAPIError = "api_error"
+
+	// CodeInvalidURL indicates a source URL, generator target, or repository
+	// reference was malformed or pointed outside what it may address.
+	CodeInvalidURL = "invalid_url"
+	// CodeRobotsDisallowed indicates robots.txt forbids crawling a path.
+	CodeRobotsDisallowed = "robots_disallowed"
+	// CodeCrawlFailed indicates the crawler could not complete a fetch.
+	CodeCrawlFailed = "crawl_failed"
+
+	// CodeCloneFailed indicates a git shallow clone failed.
+	CodeCloneFailed = "clone_failed"
+	// CodeManifestInvalid indicates an OCI manifest or its layers could
+	// not be read.
+	CodeManifestInvalid = "manifest_invalid"
+
+	// CodeArchiveFailed indicates a bundle tar/zip archive could not be
+	// built or written.
+	CodeArchiveFailed = "archive_failed"
+
+	// CodeCacheRead indicates the on-disk cache could not be read or listed.
+	CodeCacheRead = "cache_read"
+	// CodeCacheWrite indicates the on-disk cache could not be created or
+	// written to.
+	CodeCacheWrite = "cache_write"
 )
@@ -7,4 +7,7 @@ const (
 	DomainHTTP      = "http"
 	DomainGitHub    = "github"
 	DomainGenerator = "generator"
+	DomainTransfer  = "transfer"
+	DomainBundle    = "bundle"
+	DomainCache     = "cache"
 )
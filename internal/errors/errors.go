@@ -14,4 +14,10 @@ var (
 	GitHubErr = oops.In(DomainGitHub).Tags("github", "api")
 	// GeneratorErr creates errors in the generator domain.
 	GeneratorErr = oops.In(DomainGenerator).Tags("generator")
+	// TransferErr creates errors in the transfer domain.
+	TransferErr = oops.In(DomainTransfer).Tags("transfer")
+	// BundleErr creates errors in the bundle domain.
+	BundleErr = oops.In(DomainBundle).Tags("bundle")
+	// CacheErr creates errors in the on-disk cache domain.
+	CacheErr = oops.In(DomainCache).Tags("cache")
 )
@@ -0,0 +1,196 @@
+package generator
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"resty.dev/v3"
+
+	errs "github.com/g5becks/llxt/internal/errors"
+)
+
+const statusOK = 200
+
+// Crawl walks same-origin HTML pages starting from startURL, honoring
+// robots.txt and the include/exclude glob filters in opts. onPage is called
+// once per page fetched, after the total queue size for that moment is known,
+// so callers can drive a determinate progress bar. A nil logger falls back
+// to slog.Default().
+func Crawl(ctx context.Context, client *resty.Client, startURL string, opts Options, logger *slog.Logger, onPage func(done, total int)) ([]Page, error) {
+	opts = opts.withDefaults()
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	origin, err := originOf(startURL)
+	if err != nil {
+		return nil, errs.GeneratorErr.
+			Code(errs.CodeInvalidURL).
+			With("url", startURL).
+			Wrapf(err, "invalid start URL")
+	}
+
+	robots := fetchRobots(ctx, client, origin)
+
+	visited := map[string]bool{startURL: true}
+	queue := []string{startURL}
+	pages := make([]Page, 0, opts.MaxPages)
+
+	for len(queue) > 0 && len(pages) < opts.MaxPages {
+		if err := ctx.Err(); err != nil {
+			return pages, err
+		}
+
+		current := queue[0]
+		queue = queue[1:]
+
+		path := pathOf(current)
+		if !robots.allows(path) {
+			logger.Debug("skipping page disallowed by robots.txt", slog.String("url", current))
+			continue
+		}
+		if !allowed(path, opts.Include, opts.Exclude) {
+			logger.Debug("skipping page excluded by filters", slog.String("url", current))
+			continue
+		}
+
+		logger.Debug("fetching page", slog.String("url", current))
+
+		resp, err := client.R().SetContext(ctx).Get(current)
+		if err != nil || resp.StatusCode() != statusOK {
+			logger.Debug("skipping page that failed to fetch", slog.String("url", current))
+			continue
+		}
+
+		page, links := parsePage(current, resp.String())
+		pages = append(pages, page)
+		if onPage != nil {
+			onPage(len(pages), smallerOf(len(pages)+len(queue), opts.MaxPages))
+		}
+
+		for _, link := range links {
+			if len(visited) >= opts.MaxPages*maxQueueFactor {
+				break
+			}
+			if !sameOrigin(origin, link) || visited[link] {
+				continue
+			}
+			visited[link] = true
+			queue = append(queue, link)
+		}
+	}
+
+	return pages, nil
+}
+
+// maxQueueFactor bounds how many links we'll ever enqueue relative to
+// MaxPages, so a page with thousands of links can't blow up memory.
+const maxQueueFactor = 10
+
+func originOf(raw string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", err
+	}
+	return u.Scheme + "://" + u.Host, nil
+}
+
+func pathOf(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	if u.Path == "" {
+		return "/"
+	}
+	return u.Path
+}
+
+func sameOrigin(origin, link string) bool {
+	o, err := originOf(link)
+	if err != nil {
+		return false
+	}
+	return o == origin
+}
+
+// parsePage extracts a title, meta description, and every link from an HTML
+// page body, each link resolved against pageURL with its fragment removed.
+func parsePage(pageURL, body string) (Page, []string) {
+	doc, err := html.Parse(strings.NewReader(body))
+	if err != nil {
+		return Page{URL: pageURL}, nil
+	}
+
+	page := Page{URL: pageURL}
+	links := make([]string, 0)
+
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch n.Data {
+			case "title":
+				if n.FirstChild != nil {
+					page.Title = strings.TrimSpace(n.FirstChild.Data)
+				}
+			case "meta":
+				if isMetaDescription(n) {
+					page.Description = metaContent(n)
+				}
+			case "a":
+				if href := attr(n, "href"); href != "" {
+					if resolved, ok := resolve(pageURL, href); ok {
+						links = append(links, resolved)
+					}
+				}
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	return page, links
+}
+
+func isMetaDescription(n *html.Node) bool {
+	return strings.EqualFold(attr(n, "name"), "description")
+}
+
+func metaContent(n *html.Node) string {
+	return attr(n, "content")
+}
+
+func attr(n *html.Node, name string) string {
+	for _, a := range n.Attr {
+		if a.Key == name {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func resolve(base, href string) (string, bool) {
+	b, err := url.Parse(base)
+	if err != nil {
+		return "", false
+	}
+	ref, err := url.Parse(href)
+	if err != nil {
+		return "", false
+	}
+	resolved := b.ResolveReference(ref)
+	resolved.Fragment = ""
+	return resolved.String(), true
+}
+
+func smallerOf(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
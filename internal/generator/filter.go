@@ -0,0 +1,26 @@
+package generator
+
+import "path"
+
+// allowed reports whether p passes the include/exclude glob filters. An empty
+// include list allows everything; any exclude match rejects regardless of
+// include.
+func allowed(p string, include, exclude []string) bool {
+	for _, pattern := range exclude {
+		if matched, _ := path.Match(pattern, p); matched {
+			return false
+		}
+	}
+
+	if len(include) == 0 {
+		return true
+	}
+
+	for _, pattern := range include {
+		if matched, _ := path.Match(pattern, p); matched {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,27 @@
+package generator
+
+import "testing"
+
+func TestAllowed(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		include []string
+		exclude []string
+		want    bool
+	}{
+		{name: "no filters allows everything", path: "docs/guide.md", want: true},
+		{name: "exclude match rejects", path: "docs/guide.md", exclude: []string{"docs/*"}, want: false},
+		{name: "include match allows", path: "docs/guide.md", include: []string{"docs/*"}, want: true},
+		{name: "include list rejects non-match", path: "blog/post.md", include: []string{"docs/*"}, want: false},
+		{name: "exclude wins over include", path: "docs/guide.md", include: []string{"docs/*"}, exclude: []string{"docs/guide.md"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := allowed(tt.path, tt.include, tt.exclude); got != tt.want {
+				t.Fatalf("allowed(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Package generator builds llms.txt documents by crawling a website or
+// indexing a GitHub repository's documentation files.
+package generator
+
+// Options controls how a generator run discovers and filters content.
+type Options struct {
+	MaxPages int
+	Include  []string
+	Exclude  []string
+}
+
+// Page represents a single crawled HTML page.
+type Page struct {
+	URL         string
+	Title       string
+	Description string
+}
+
+// Doc represents a single documentation file indexed from a repository.
+type Doc struct {
+	Path  string
+	Title string
+}
+
+const defaultMaxPages = 100
+
+// withDefaults fills in zero-valued fields with their defaults.
+func (o Options) withDefaults() Options {
+	if o.MaxPages <= 0 {
+		o.MaxPages = defaultMaxPages
+	}
+	return o
+}
@@ -0,0 +1,110 @@
+package generator
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+)
+
+// BuildSiteDoc renders a crawled page set into an llms.txt document: an H1
+// title, a blockquote summary, and H2 sections grouping links by their first
+// path segment (e.g. "/docs/...", "/guides/...").
+func BuildSiteDoc(title string, pages []Page) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", title)
+	fmt.Fprintf(&b, "> %d pages indexed from %s\n\n", len(pages), title)
+
+	groups := groupPagesBySection(pages)
+	for _, section := range sortedKeys(groups) {
+		fmt.Fprintf(&b, "## %s\n\n", section)
+		for _, p := range groups[section] {
+			writeLink(&b, p.URL, p.Title, p.Description)
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// BuildRepoDoc renders an indexed repository doc set into an llms.txt
+// document, grouping files by their containing directory.
+func BuildRepoDoc(owner, repo string, docs []Doc) string {
+	var b strings.Builder
+
+	fullName := owner + "/" + repo
+	fmt.Fprintf(&b, "# %s\n\n", fullName)
+	fmt.Fprintf(&b, "> %d documentation files indexed from %s\n\n", len(docs), fullName)
+
+	groups := make(map[string][]Doc)
+	for _, d := range docs {
+		dir := path.Dir(d.Path)
+		if dir == "." {
+			dir = "Root"
+		}
+		groups[dir] = append(groups[dir], d)
+	}
+
+	for _, section := range sortedDocKeys(groups) {
+		fmt.Fprintf(&b, "## %s\n\n", section)
+		for _, d := range groups[section] {
+			fmt.Fprintf(&b, "- [%s](%s)\n", d.Title, d.Path)
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func groupPagesBySection(pages []Page) map[string][]Page {
+	groups := make(map[string][]Page)
+	for _, p := range pages {
+		groups[sectionOf(p.URL)] = append(groups[sectionOf(p.URL)], p)
+	}
+	return groups
+}
+
+func sectionOf(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "General"
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segments) == 0 || segments[0] == "" {
+		return "General"
+	}
+
+	return strings.ToUpper(segments[0][:1]) + segments[0][1:]
+}
+
+func writeLink(b *strings.Builder, linkURL, title, description string) {
+	if title == "" {
+		title = linkURL
+	}
+	if description == "" {
+		fmt.Fprintf(b, "- [%s](%s)\n", title, linkURL)
+		return
+	}
+	fmt.Fprintf(b, "- [%s](%s): %s\n", title, linkURL, description)
+}
+
+func sortedKeys(groups map[string][]Page) []string {
+	keys := make([]string, 0, len(groups))
+	for k := range groups {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedDocKeys(groups map[string][]Doc) []string {
+	keys := make([]string, 0, len(groups))
+	for k := range groups {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"context"
+	"strings"
+
+	ghclient "github.com/g5becks/llxt/internal/github"
+)
+
+// IndexRepo walks a GitHub repository's default branch, selects README and
+// docs Markdown files (subject to the include/exclude filters), and fetches
+// each one's title (its first H1, falling back to the file path). onDoc is
+// called once per file fetched for progress reporting.
+func IndexRepo(ctx context.Context, client *ghclient.Client, owner, repo string, opts Options, onDoc func(done, total int)) ([]Doc, error) {
+	opts = opts.withDefaults()
+
+	ref, err := client.DefaultBranch(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err := client.Tree(ctx, owner, repo, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := ghclient.DocPaths(paths)
+
+	filtered := make([]string, 0, len(candidates))
+	for _, p := range candidates {
+		if allowed(p, opts.Include, opts.Exclude) {
+			filtered = append(filtered, p)
+		}
+	}
+
+	if len(filtered) > opts.MaxPages {
+		filtered = filtered[:opts.MaxPages]
+	}
+
+	docs := make([]Doc, 0, len(filtered))
+	for i, p := range filtered {
+		if err := ctx.Err(); err != nil {
+			return docs, err
+		}
+
+		content, err := client.Content(ctx, owner, repo, p, ref)
+		if err != nil {
+			continue
+		}
+
+		docs = append(docs, Doc{Path: p, Title: firstHeading(content, p)})
+		if onDoc != nil {
+			onDoc(i+1, len(filtered))
+		}
+	}
+
+	return docs, nil
+}
+
+// firstHeading returns the text of the first Markdown H1 in content, falling
+// back to fallback when none is found.
+func firstHeading(content, fallback string) string {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "# ") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "#"))
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,68 @@
+package generator
+
+import (
+	"bufio"
+	"context"
+	"strings"
+
+	"resty.dev/v3"
+)
+
+// robotsRules holds the Disallow prefixes that apply to all user agents.
+type robotsRules struct {
+	disallow []string
+}
+
+// fetchRobots retrieves and parses robots.txt for the given origin. A missing
+// or unreadable robots.txt is treated as "allow everything", matching how
+// most crawlers behave when the file is absent.
+func fetchRobots(ctx context.Context, client *resty.Client, origin string) *robotsRules {
+	resp, err := client.R().SetContext(ctx).Get(origin + "/robots.txt")
+	if err != nil || resp.StatusCode() != statusOK {
+		return &robotsRules{}
+	}
+
+	return parseRobots(resp.String())
+}
+
+func parseRobots(body string) *robotsRules {
+	rules := &robotsRules{}
+	relevant := false
+
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			relevant = value == "*"
+		case "disallow":
+			if relevant && value != "" {
+				rules.disallow = append(rules.disallow, value)
+			}
+		}
+	}
+
+	return rules
+}
+
+// allows reports whether path is permitted by the parsed rules.
+func (r *robotsRules) allows(path string) bool {
+	for _, prefix := range r.disallow {
+		if strings.HasPrefix(path, prefix) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,40 @@
+package generator
+
+import "testing"
+
+func TestParseRobots(t *testing.T) {
+	body := `# comment
+User-agent: Googlebot
+Disallow: /only-googlebot
+
+User-agent: *
+Disallow: /admin
+Disallow: /private
+`
+
+	rules := parseRobots(body)
+
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "/admin", want: false},
+		{path: "/admin/users", want: false},
+		{path: "/private", want: false},
+		{path: "/only-googlebot", want: true},
+		{path: "/docs", want: true},
+	}
+
+	for _, tt := range tests {
+		if got := rules.allows(tt.path); got != tt.want {
+			t.Errorf("allows(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestParseRobotsEmpty(t *testing.T) {
+	rules := parseRobots("")
+	if !rules.allows("/anything") {
+		t.Fatal("allows() = false for an empty robots.txt, want true")
+	}
+}
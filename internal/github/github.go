@@ -0,0 +1,186 @@
+// Package github provides read-only access to repository trees and file
+// contents via the GitHub REST API, used by the generator package to index
+// documentation for llms.txt generation.
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+
+	"resty.dev/v3"
+
+	errs "github.com/g5becks/llxt/internal/errors"
+)
+
+const (
+	apiBaseURL     = "https://api.github.com"
+	statusNotFound = 404
+)
+
+// Client fetches repository metadata, trees, and file contents.
+type Client struct {
+	http   *resty.Client
+	logger *slog.Logger
+}
+
+// NewClient wraps an existing HTTP client for GitHub API calls. A nil logger
+// falls back to slog.Default().
+func NewClient(http *resty.Client, logger *slog.Logger) *Client {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Client{http: http, logger: logger}
+}
+
+type repoResponse struct {
+	DefaultBranch string `json:"default_branch"`
+}
+
+// DefaultBranch resolves the repository's default branch (e.g. "main").
+func (c *Client) DefaultBranch(ctx context.Context, owner, repo string) (string, error) {
+	var out repoResponse
+
+	c.logger.Debug("fetching repository", slog.String("owner", owner), slog.String("repo", repo))
+
+	resp, err := c.http.R().
+		SetContext(ctx).
+		SetResult(&out).
+		Get(fmt.Sprintf("%s/repos/%s/%s", apiBaseURL, owner, repo))
+	if err != nil {
+		return "", errs.GitHubErr.
+			Code(errs.CodeAPIError).
+			With("owner", owner).
+			With("repo", repo).
+			Wrapf(err, "failed to fetch repository")
+	}
+
+	if resp.StatusCode() == statusNotFound {
+		return "", errs.GitHubErr.
+			Code(errs.CodeRepoNotFound).
+			With("owner", owner).
+			With("repo", repo).
+			Errorf("repository %s/%s not found", owner, repo)
+	}
+
+	return out.DefaultBranch, nil
+}
+
+type treeResponse struct {
+	Tree      []treeEntry `json:"tree"`
+	Truncated bool        `json:"truncated"`
+}
+
+type treeEntry struct {
+	Path string `json:"path"`
+	Type string `json:"type"`
+}
+
+// Tree walks the full recursive file tree of a repository at ref, returning
+// blob paths only (directories are omitted).
+func (c *Client) Tree(ctx context.Context, owner, repo, ref string) ([]string, error) {
+	var out treeResponse
+
+	c.logger.Debug("fetching tree", slog.String("owner", owner), slog.String("repo", repo), slog.String("ref", ref))
+
+	resp, err := c.http.R().
+		SetContext(ctx).
+		SetResult(&out).
+		Get(fmt.Sprintf("%s/repos/%s/%s/git/trees/%s?recursive=1", apiBaseURL, owner, repo, ref))
+	if err != nil {
+		return nil, errs.GitHubErr.
+			Code(errs.CodeAPIError).
+			With("owner", owner).
+			With("repo", repo).
+			With("ref", ref).
+			Wrapf(err, "failed to fetch tree")
+	}
+
+	if resp.StatusCode() == statusNotFound {
+		return nil, errs.GitHubErr.
+			Code(errs.CodePathNotFound).
+			With("owner", owner).
+			With("repo", repo).
+			With("ref", ref).
+			Errorf("tree not found for %s/%s@%s", owner, repo, ref)
+	}
+
+	if out.Truncated {
+		c.logger.Warn("tree listing was truncated by the GitHub API; some files will be missing from the index",
+			slog.String("owner", owner), slog.String("repo", repo), slog.String("ref", ref))
+	}
+
+	paths := make([]string, 0, len(out.Tree))
+	for _, entry := range out.Tree {
+		if entry.Type == "blob" {
+			paths = append(paths, entry.Path)
+		}
+	}
+
+	return paths, nil
+}
+
+// DocPaths filters a tree listing down to README files and Markdown files
+// that live under a docs-like directory.
+func DocPaths(paths []string) []string {
+	docs := make([]string, 0)
+
+	for _, p := range paths {
+		base := strings.ToLower(path.Base(p))
+		switch {
+		case strings.HasPrefix(base, "readme"):
+			docs = append(docs, p)
+		case strings.HasSuffix(base, ".md") && strings.Contains(strings.ToLower(path.Dir(p)), "doc"):
+			docs = append(docs, p)
+		}
+	}
+
+	return docs
+}
+
+type contentResponse struct {
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+// Content fetches and decodes a single file's contents at ref.
+func (c *Client) Content(ctx context.Context, owner, repo, filePath, ref string) (string, error) {
+	var out contentResponse
+
+	c.logger.Debug("fetching file contents", slog.String("path", filePath), slog.String("ref", ref))
+
+	resp, err := c.http.R().
+		SetContext(ctx).
+		SetResult(&out).
+		Get(fmt.Sprintf("%s/repos/%s/%s/contents/%s?ref=%s", apiBaseURL, owner, repo, filePath, ref))
+	if err != nil {
+		return "", errs.GitHubErr.
+			Code(errs.CodeAPIError).
+			With("path", filePath).
+			Wrapf(err, "failed to fetch file contents")
+	}
+
+	if resp.StatusCode() == statusNotFound {
+		return "", errs.GitHubErr.
+			Code(errs.CodePathNotFound).
+			With("path", filePath).
+			Errorf("path %q not found", filePath)
+	}
+
+	if out.Encoding != "base64" {
+		return out.Content, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(out.Content, "\n", ""))
+	if err != nil {
+		return "", errs.GitHubErr.
+			Code(errs.CodeAPIError).
+			With("path", filePath).
+			Wrapf(err, "failed to decode file contents")
+	}
+
+	return string(decoded), nil
+}
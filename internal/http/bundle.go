@@ -0,0 +1,63 @@
+package httpclient
+
+import (
+	"context"
+	"sync"
+)
+
+// Target identifies a single source to fetch as part of a FetchMany batch.
+type Target struct {
+	Name     string
+	URL      string
+	FullURL  *string
+	Full     bool
+	Transfer string
+}
+
+// Result is the outcome of fetching a single Target.
+type Result struct {
+	Target  Target
+	Content string
+	Err     error
+}
+
+// FetchMany fetches every target concurrently, bounded by concurrency, and
+// streams results back as they complete. A failed target does not stop the
+// others; its error is carried on its Result. The returned channel is closed
+// once every target has been attempted.
+func (f *Fetcher) FetchMany(ctx context.Context, targets []Target, concurrency int, copts CacheOptions) <-chan Result {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	results := make(chan Result)
+	sem := make(chan struct{}, concurrency)
+
+	go func() {
+		defer close(results)
+
+		var wg sync.WaitGroup
+		for _, t := range targets {
+			select {
+			case <-ctx.Done():
+				results <- Result{Target: t, Err: ctx.Err()}
+				continue
+			default:
+			}
+
+			wg.Add(1)
+			sem <- struct{}{}
+
+			go func(target Target) {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				content, err := f.FetchLLMsTxt(ctx, target.URL, target.FullURL, target.Full, target.Transfer, copts)
+				results <- Result{Target: target, Content: content, Err: err}
+			}(t)
+		}
+		wg.Wait()
+	}()
+
+	return results
+}
@@ -2,7 +2,6 @@ package httpclient
 
 import (
 	"log/slog"
-	"os"
 	"time"
 
 	"resty.dev/v3"
@@ -14,22 +13,25 @@ const (
 	cbResetTimeout     = 30 * time.Second
 )
 
-//nolint:gochecknoglobals // logger is shared across package
-var logger = slog.New(slog.NewTextHandler(os.Stderr, nil))
-
-// NewClient creates a new HTTP client with retry and circuit breaker.
-func NewClient(cfg *Config) *resty.Client {
+// NewClient creates a new HTTP client with retry and circuit breaker. A nil
+// logger falls back to slog.Default() so callers that don't care about
+// logging configuration can still pass nil.
+func NewClient(cfg *Config, logger *slog.Logger) *resty.Client {
 	if cfg == nil {
 		cfg = DefaultConfig()
 	}
+	if logger == nil {
+		logger = slog.Default()
+	}
 
 	// Create circuit breaker
-	cb := resty.NewCircuitBreakerWithCount(
+	cb := resty.NewCircuitBreakerCount(
 		cbFailureThreshold,
 		cbSuccessThreshold,
 		cbResetTimeout,
 		resty.CircuitBreaker5xxPolicy,
-	).OnStateChange(func(oldState, newState resty.CircuitBreakerState) {
+	)
+	cb.OnStateChange(func(oldState, newState resty.CircuitBreakerState) {
 		logger.Warn("circuit breaker state changed",
 			slog.Any("from", oldState),
 			slog.Any("to", newState),
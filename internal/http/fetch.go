@@ -1,26 +1,52 @@
 package httpclient
 
 import (
+	"context"
+	"io"
+	"log/slog"
+	"time"
+
 	"resty.dev/v3"
 
+	"github.com/g5becks/llxt/internal/cache"
 	errs "github.com/g5becks/llxt/internal/errors"
+	"github.com/g5becks/llxt/internal/transfer"
 )
 
 const (
 	statusNotFound    = 404
+	statusNotModified = 304
 	statusRateLimited = 429
 	statusBadRequest  = 400
 )
 
 // Fetcher handles fetching llms.txt content.
 type Fetcher struct {
-	client *resty.Client
+	client    *resty.Client
+	logger    *slog.Logger
+	transfers *transfer.Registry
 }
 
-// NewFetcher creates a new Fetcher.
-func NewFetcher(cfg *Config) *Fetcher {
+// NewFetcher creates a new Fetcher. A nil logger falls back to slog.Default().
+// Besides the default HTTPS adapter, it registers git, S3, and OCI transfer
+// adapters so registry entries can opt into fetching over those instead.
+func NewFetcher(cfg *Config, logger *slog.Logger) *Fetcher {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	client := NewClient(cfg, logger)
+
+	transfers := transfer.NewRegistry()
+	transfers.Register(newHTTPSAdapter(client))
+	transfers.Register(transfer.NewGitAdapter())
+	transfers.Register(transfer.NewS3Adapter())
+	transfers.Register(transfer.NewOCIAdapter())
+
 	return &Fetcher{
-		client: NewClient(cfg),
+		client:    client,
+		logger:    logger,
+		transfers: transfers,
 	}
 }
 
@@ -29,9 +55,91 @@ func (f *Fetcher) Close() error {
 	return f.client.Close()
 }
 
-// Fetch retrieves content from a URL.
-func (f *Fetcher) Fetch(url string) (string, error) {
-	resp, err := f.client.R().Get(url)
+// CacheOptions controls whether and how a fetch consults the on-disk cache.
+type CacheOptions struct {
+	// Cache is the store to consult. A nil Cache disables caching entirely.
+	Cache *cache.Cache
+	// NoCache skips the cache for this fetch (neither read nor written).
+	NoCache bool
+	// Refresh re-fetches from the origin unconditionally, ignoring MaxAge
+	// and any cached ETag/Last-Modified.
+	Refresh bool
+	// MaxAge serves a cached entry without revalidation if it is fresh
+	// enough. Zero means always revalidate.
+	MaxAge time.Duration
+}
+
+// FetchLLMsTxt fetches llms.txt or llms-full.txt based on full flag,
+// consulting the cache described by copts when one is configured.
+// transferHint names a specific transfer adapter (e.g. "git", "s3", "oci");
+// empty dispatches to whichever registered adapter first reports it
+// supports the URL.
+func (f *Fetcher) FetchLLMsTxt(ctx context.Context, url string, fullURL *string, full bool, transferHint string, copts CacheOptions) (string, error) {
+	targetURL := url
+	if full && fullURL != nil {
+		targetURL = *fullURL
+	}
+
+	adapter, err := f.transfers.Resolve(targetURL, transferHint)
+	if err != nil {
+		return "", err
+	}
+
+	if copts.Cache == nil || copts.NoCache {
+		return f.fetchVia(ctx, adapter, targetURL)
+	}
+
+	return f.fetchCached(ctx, adapter, targetURL, copts)
+}
+
+// fetchVia reads the full body of url through adapter, bypassing the cache.
+// Every adapter, including HTTPS, reads through transfer.Adapter.Fetch so
+// ctx cancellation actually reaches the in-flight request.
+func (f *Fetcher) fetchVia(ctx context.Context, adapter transfer.Adapter, url string) (string, error) {
+	rc, _, err := adapter.Fetch(ctx, url)
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	body, err := io.ReadAll(rc)
+	if err != nil {
+		return "", errs.HTTPErr.
+			Code(errs.CodeNetworkFailure).
+			With("url", url).
+			Wrapf(err, "failed to read fetched content")
+	}
+
+	return string(body), nil
+}
+
+func (f *Fetcher) fetchCached(ctx context.Context, adapter transfer.Adapter, url string, copts CacheOptions) (string, error) {
+	if adapter.Name() != httpsAdapterName {
+		return f.fetchCachedGeneric(ctx, adapter, url, copts)
+	}
+
+	entry, hit := copts.Cache.Get(url)
+
+	if hit && !copts.Refresh && copts.MaxAge > 0 && time.Since(entry.FetchedAt) < copts.MaxAge {
+		f.logger.Debug("cache hit",
+			slog.String("url", url),
+			slog.String("reason", "fresh"),
+			slog.Int("bytes_saved", len(entry.Body)),
+		)
+		return entry.Body, nil
+	}
+
+	req := f.client.R().SetContext(ctx)
+	if hit && !copts.Refresh {
+		if entry.ETag != "" {
+			req.SetHeader("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			req.SetHeader("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	resp, err := req.Get(url)
 	if err != nil {
 		return "", errs.HTTPErr.
 			Code(errs.CodeNetworkFailure).
@@ -39,39 +147,101 @@ func (f *Fetcher) Fetch(url string) (string, error) {
 			Wrapf(err, "failed to fetch content")
 	}
 
-	if resp.StatusCode() == statusNotFound {
+	if hit && resp.StatusCode() == statusNotModified {
+		f.logger.Debug("cache revalidated",
+			slog.String("url", url),
+			slog.Int("bytes_saved", len(entry.Body)),
+		)
+		entry.FetchedAt = time.Now()
+		_ = copts.Cache.Put(entry)
+		return entry.Body, nil
+	}
+
+	if err := checkStatus(resp, url); err != nil {
+		return "", err
+	}
+
+	body := resp.String()
+	f.logger.Debug("cache miss", slog.String("url", url))
+
+	_ = copts.Cache.Put(&cache.Entry{
+		URL:          url,
+		Body:         body,
+		ETag:         resp.Header().Get("ETag"),
+		LastModified: resp.Header().Get("Last-Modified"),
+		FetchedAt:    time.Now(),
+	})
+
+	return body, nil
+}
+
+// fetchCachedGeneric caches fetches through adapters that have no
+// conditional-request support (git, S3, OCI): a fresh cache hit is served
+// as-is within copts.MaxAge, otherwise the full content is re-fetched and
+// the entry overwritten, using whatever revalidation metadata the adapter
+// reports in its Meta.
+func (f *Fetcher) fetchCachedGeneric(ctx context.Context, adapter transfer.Adapter, url string, copts CacheOptions) (string, error) {
+	entry, hit := copts.Cache.Get(url)
+
+	if hit && !copts.Refresh && copts.MaxAge > 0 && time.Since(entry.FetchedAt) < copts.MaxAge {
+		f.logger.Debug("cache hit",
+			slog.String("url", url),
+			slog.String("reason", "fresh"),
+			slog.Int("bytes_saved", len(entry.Body)),
+		)
+		return entry.Body, nil
+	}
+
+	rc, meta, err := adapter.Fetch(ctx, url)
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
 		return "", errs.HTTPErr.
+			Code(errs.CodeNetworkFailure).
+			With("url", url).
+			Wrapf(err, "failed to read fetched content")
+	}
+
+	body := string(data)
+	f.logger.Debug("cache miss", slog.String("url", url))
+
+	_ = copts.Cache.Put(&cache.Entry{
+		URL:          url,
+		Body:         body,
+		ETag:         meta.ETag,
+		LastModified: meta.LastModified,
+		FetchedAt:    time.Now(),
+	})
+
+	return body, nil
+}
+
+func checkStatus(resp *resty.Response, url string) error {
+	switch {
+	case resp.StatusCode() == statusNotFound:
+		return errs.HTTPErr.
 			Code(errs.CodeNotFound).
 			With("url", url).
 			With("status", resp.StatusCode()).
 			Errorf("resource not found")
-	}
-
-	if resp.StatusCode() == statusRateLimited {
-		return "", errs.HTTPErr.
+	case resp.StatusCode() == statusRateLimited:
+		return errs.HTTPErr.
 			Code(errs.CodeRateLimited).
 			With("url", url).
 			With("retry_after", resp.Header().Get("Retry-After")).
 			Hint("Wait before retrying or use a different source").
 			Errorf("rate limited by server")
-	}
-
-	if resp.StatusCode() >= statusBadRequest {
-		return "", errs.HTTPErr.
+	case resp.StatusCode() >= statusBadRequest:
+		return errs.HTTPErr.
 			Code(errs.CodeNetworkFailure).
 			With("url", url).
 			With("status", resp.StatusCode()).
 			Errorf("HTTP error: %d", resp.StatusCode())
+	default:
+		return nil
 	}
-
-	return resp.String(), nil
-}
-
-// FetchLLMsTxt fetches llms.txt or llms-full.txt based on full flag.
-func (f *Fetcher) FetchLLMsTxt(url string, fullURL *string, full bool) (string, error) {
-	targetURL := url
-	if full && fullURL != nil {
-		targetURL = *fullURL
-	}
-	return f.Fetch(targetURL)
 }
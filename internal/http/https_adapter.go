@@ -0,0 +1,59 @@
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+
+	"resty.dev/v3"
+
+	errs "github.com/g5becks/llxt/internal/errors"
+	"github.com/g5becks/llxt/internal/transfer"
+)
+
+// httpsAdapterName is the Name() of httpsAdapter, used to detect it without
+// a type assertion so Fetcher can keep its cache-revalidation codepath.
+const httpsAdapterName = "https"
+
+// httpsAdapter wraps the package's resty client as a transfer.Adapter, so
+// the default registry dispatches plain HTTP(S) URLs the same way it
+// dispatches git, S3, and OCI sources.
+type httpsAdapter struct {
+	client *resty.Client
+}
+
+func newHTTPSAdapter(client *resty.Client) *httpsAdapter {
+	return &httpsAdapter{client: client}
+}
+
+// Name identifies this adapter for registry hints.
+func (a *httpsAdapter) Name() string { return httpsAdapterName }
+
+// Supports reports whether url is a plain HTTP(S) URL.
+func (a *httpsAdapter) Supports(url string) bool {
+	return strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "https://")
+}
+
+// Fetch retrieves url over HTTP(S), surfacing its ETag/Last-Modified headers
+// so callers can revalidate future fetches.
+func (a *httpsAdapter) Fetch(ctx context.Context, url string) (io.ReadCloser, transfer.Meta, error) {
+	resp, err := a.client.R().SetContext(ctx).Get(url)
+	if err != nil {
+		return nil, transfer.Meta{}, errs.HTTPErr.
+			Code(errs.CodeNetworkFailure).
+			With("url", url).
+			Wrapf(err, "failed to fetch content")
+	}
+
+	if err := checkStatus(resp, url); err != nil {
+		return nil, transfer.Meta{}, err
+	}
+
+	meta := transfer.Meta{
+		ETag:         resp.Header().Get("ETag"),
+		LastModified: resp.Header().Get("Last-Modified"),
+	}
+
+	return io.NopCloser(bytes.NewReader(resp.Bytes())), meta, nil
+}
@@ -0,0 +1,25 @@
+package log
+
+import (
+	"log/slog"
+
+	"github.com/samber/oops"
+)
+
+// Error logs err at error level. When err carries an oops.OopsError, its
+// domain, code, and context are surfaced as top-level fields so JSON output
+// stays greppable without unpacking the error string.
+func Error(logger *slog.Logger, msg string, err error) {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		logger.Error(msg, slog.Any("error", err))
+		return
+	}
+
+	logger.Error(msg,
+		slog.String("domain", oopsErr.Domain()),
+		slog.Any("code", oopsErr.Code()),
+		slog.Any("context", oopsErr.Context()),
+		slog.Any("error", err),
+	)
+}
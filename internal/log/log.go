@@ -0,0 +1,77 @@
+// Package log builds the leveled slog.Logger used across llxt, so every
+// subsystem logs through one consistently configured handler instead of each
+// constructing its own.
+package log
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// LevelTrace is more verbose than slog.LevelDebug. It is selected by a
+// Level of "trace"; retry attempts, cache lookups, and per-page crawl
+// output are logged at debug level, not trace.
+const LevelTrace = slog.Level(-8)
+
+const (
+	formatJSON = "json"
+	formatText = "text"
+)
+
+// Options configures the logger built by New. Level takes precedence over
+// Verbose/Quiet when set.
+type Options struct {
+	Verbose bool
+	Quiet   bool
+	Level   string
+	Format  string
+}
+
+// New builds a *slog.Logger writing to stderr per opts.
+func New(opts Options) *slog.Logger {
+	handlerOpts := &slog.HandlerOptions{Level: resolveLevel(opts)}
+
+	var handler slog.Handler
+	if strings.EqualFold(opts.Format, formatJSON) {
+		handler = slog.NewJSONHandler(os.Stderr, handlerOpts)
+	} else {
+		handler = slog.NewTextHandler(os.Stderr, handlerOpts)
+	}
+
+	return slog.New(handler)
+}
+
+func resolveLevel(opts Options) slog.Level {
+	if opts.Level != "" {
+		return parseLevel(opts.Level)
+	}
+	if opts.Quiet {
+		return slog.LevelError
+	}
+	if opts.Verbose {
+		return slog.LevelDebug
+	}
+	return slog.LevelInfo
+}
+
+func parseLevel(s string) slog.Level {
+	switch strings.ToLower(s) {
+	case "trace":
+		return LevelTrace
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// Trace logs msg at LevelTrace.
+func Trace(logger *slog.Logger, msg string, args ...any) {
+	logger.Log(context.Background(), LevelTrace, msg, args...)
+}
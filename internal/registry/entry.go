@@ -10,4 +10,7 @@ type Entry struct {
 	Category    string  `json:"category,omitempty"`
 	LLMsURL     string  `json:"llms_url"`
 	LLMsFullURL *string `json:"llms_full_url,omitempty"`
+	// Transfer optionally names the transfer adapter to dispatch through
+	// (e.g. "git", "s3", "oci"); empty means pick by URL scheme.
+	Transfer string `json:"transfer,omitempty"`
 }
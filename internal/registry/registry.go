@@ -7,6 +7,15 @@ import (
 	errs "github.com/g5becks/llxt/internal/errors"
 )
 
+// Merge overlays entries onto the embedded registry, keyed case-insensitively,
+// with overlay entries winning on collision. It's used at startup to apply
+// the user's sources.toml and any configured remote team index.
+func Merge(overlay []*Entry) {
+	for _, e := range overlay {
+		entries[strings.ToLower(e.Key)] = e
+	}
+}
+
 // Lookup finds an entry by key (case-insensitive).
 func Lookup(key string) (*Entry, error) {
 	k := strings.ToLower(key)
@@ -0,0 +1,140 @@
+package transfer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	errs "github.com/g5becks/llxt/internal/errors"
+)
+
+// GitAdapter fetches a file out of a git repository via a shallow clone, for
+// sources published as a file in a repo rather than served over HTTP.
+type GitAdapter struct{}
+
+// NewGitAdapter returns a GitAdapter.
+func NewGitAdapter() *GitAdapter {
+	return &GitAdapter{}
+}
+
+// Name identifies this adapter for registry hints.
+func (a *GitAdapter) Name() string { return "git" }
+
+// Supports reports whether url names a git remote this adapter can clone.
+func (a *GitAdapter) Supports(url string) bool {
+	return strings.HasPrefix(url, "git://") || strings.HasPrefix(url, "ssh://git@")
+}
+
+// Fetch shallow-clones the repository named by url and returns the file at
+// its fragment path, e.g. "git://github.com/org/repo#docs/llms.txt".
+func (a *GitAdapter) Fetch(ctx context.Context, url string) (io.ReadCloser, Meta, error) {
+	remote, path, err := splitGitURL(url)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	dir, err := os.MkdirTemp("", "llxt-git-*")
+	if err != nil {
+		return nil, Meta{}, errs.TransferErr.
+			Code(errs.CodeCloneFailed).
+			With("remote", remote).
+			Wrapf(err, "failed to create temporary clone directory")
+	}
+	defer os.RemoveAll(dir)
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "git", "clone", "--depth", "1", "--quiet", remote, dir)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, Meta{}, errs.TransferErr.
+			Code(errs.CodeCloneFailed).
+			With("remote", remote).
+			With("stderr", stderr.String()).
+			Wrapf(err, "git clone failed")
+	}
+
+	target, err := safeJoin(dir, path)
+	if err != nil {
+		return nil, Meta{}, errs.TransferErr.
+			Code(errs.CodeInvalidURL).
+			With("remote", remote).
+			With("path", path).
+			Wrapf(err, "path escapes the cloned repository")
+	}
+
+	resolved, err := resolveNoEscape(dir, target)
+	if err != nil {
+		return nil, Meta{}, errs.TransferErr.
+			Code(errs.CodeInvalidURL).
+			With("remote", remote).
+			With("path", path).
+			Wrapf(err, "path escapes the cloned repository")
+	}
+
+	data, err := os.ReadFile(resolved)
+	if err != nil {
+		return nil, Meta{}, errs.TransferErr.
+			Code(errs.CodeNotFound).
+			With("remote", remote).
+			With("path", path).
+			Wrapf(err, "failed to read file from cloned repository")
+	}
+
+	return io.NopCloser(bytes.NewReader(data)), Meta{}, nil
+}
+
+// safeJoin joins dir and path, rejecting any path that would resolve
+// outside of dir (e.g. via ".." segments), so a malicious or compromised
+// registry entry can't read arbitrary local files through the clone.
+func safeJoin(dir, path string) (string, error) {
+	joined := filepath.Join(dir, path)
+
+	rel, err := filepath.Rel(dir, joined)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("path %q escapes the repository root", path)
+	}
+
+	return joined, nil
+}
+
+// resolveNoEscape evaluates any symlinks in target (including the cloned
+// tree itself, e.g. a tracked "llms.txt -> /etc/passwd") and rejects the
+// result if it resolves outside of dir. safeJoin alone only catches ".."
+// segments in the requested path string; it can't see a symlink planted in
+// the checked-out tree that points elsewhere on disk.
+func resolveNoEscape(dir, target string) (string, error) {
+	resolved, err := filepath.EvalSymlinks(target)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %q: %w", target, err)
+	}
+
+	realDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve repository root: %w", err)
+	}
+
+	rel, err := filepath.Rel(realDir, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("path %q resolves outside the repository root", target)
+	}
+
+	return resolved, nil
+}
+
+// splitGitURL separates a git adapter URL into the clonable remote and the
+// path within the repository, joined by a "#".
+func splitGitURL(url string) (remote, path string, err error) {
+	remote, path, found := strings.Cut(url, "#")
+	if !found || path == "" {
+		return "", "", errs.TransferErr.
+			Code(errs.CodeInvalidURL).
+			With("url", url).
+			Errorf("git source url must include a file path fragment, e.g. %q", "git://host/org/repo#path/to/llms.txt")
+	}
+	return remote, path, nil
+}
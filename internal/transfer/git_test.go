@@ -0,0 +1,79 @@
+package transfer
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestSafeJoin(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{name: "plain relative path", path: "docs/llms.txt"},
+		{name: "dot-dot escapes", path: "../../etc/passwd", wantErr: true},
+		{name: "absolute-looking path stays joined", path: "/etc/passwd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := safeJoin(dir, tt.path)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("safeJoin(%q) error = nil, want error", tt.path)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("safeJoin(%q) error = %v, want nil", tt.path, err)
+			}
+			if rel, relErr := filepath.Rel(dir, got); relErr != nil || rel == ".." {
+				t.Fatalf("safeJoin(%q) = %q, want a path inside %q", tt.path, got, dir)
+			}
+		})
+	}
+}
+
+func TestResolveNoEscapeRejectsSymlinkOutsideRoot(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks require elevated privileges on windows")
+	}
+
+	dir := t.TempDir()
+	outside := t.TempDir()
+
+	secret := filepath.Join(outside, "secret.txt")
+	if err := os.WriteFile(secret, []byte("sensitive"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v, want nil", err)
+	}
+
+	link := filepath.Join(dir, "llms.txt")
+	if err := os.Symlink(secret, link); err != nil {
+		t.Fatalf("Symlink() error = %v, want nil", err)
+	}
+
+	if _, err := resolveNoEscape(dir, link); err == nil {
+		t.Fatal("resolveNoEscape() error = nil for a symlink escaping the root, want error")
+	}
+}
+
+func TestResolveNoEscapeAllowsPlainFile(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "llms.txt")
+	if err := os.WriteFile(target, []byte("hello"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v, want nil", err)
+	}
+
+	resolved, err := resolveNoEscape(dir, target)
+	if err != nil {
+		t.Fatalf("resolveNoEscape() error = %v, want nil", err)
+	}
+	if resolved == "" {
+		t.Fatal("resolveNoEscape() returned empty path")
+	}
+}
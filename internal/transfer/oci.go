@@ -0,0 +1,126 @@
+package transfer
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	errs "github.com/g5becks/llxt/internal/errors"
+)
+
+const defaultOCIFile = "llms.txt"
+
+// OCIAdapter fetches llms.txt from an OCI artifact, pulling the manifest and
+// reading the file out of the first layer that contains it, the way tools
+// like ORAS distribute non-image blobs through registries.
+type OCIAdapter struct{}
+
+// NewOCIAdapter returns an OCIAdapter.
+func NewOCIAdapter() *OCIAdapter {
+	return &OCIAdapter{}
+}
+
+// Name identifies this adapter for registry hints.
+func (a *OCIAdapter) Name() string { return "oci" }
+
+// Supports reports whether url names an OCI artifact this adapter can pull.
+func (a *OCIAdapter) Supports(url string) bool {
+	return strings.HasPrefix(url, "oci://")
+}
+
+// Fetch pulls the image referenced by url, e.g. "oci://registry/repo:tag",
+// and returns the named file (default "llms.txt") from its layers.
+func (a *OCIAdapter) Fetch(ctx context.Context, url string) (io.ReadCloser, Meta, error) {
+	ref, file, err := splitOCIURL(url)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	r, err := name.ParseReference(ref)
+	if err != nil {
+		return nil, Meta{}, errs.TransferErr.
+			Code(errs.CodeInvalidURL).
+			With("ref", ref).
+			Wrapf(err, "invalid OCI reference")
+	}
+
+	img, err := remote.Image(r, remote.WithContext(ctx))
+	if err != nil {
+		return nil, Meta{}, errs.TransferErr.
+			Code(errs.CodeNetworkFailure).
+			With("ref", ref).
+			Wrapf(err, "failed to pull OCI image")
+	}
+
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, Meta{}, errs.TransferErr.
+			Code(errs.CodeManifestInvalid).
+			With("ref", ref).
+			Wrapf(err, "failed to read OCI manifest layers")
+	}
+
+	for _, layer := range layers {
+		rc, err := layer.Uncompressed()
+		if err != nil {
+			continue
+		}
+		if data, ok := readTarFile(rc, file); ok {
+			return io.NopCloser(bytes.NewReader(data)), Meta{}, nil
+		}
+	}
+
+	return nil, Meta{}, errs.TransferErr.
+		Code(errs.CodeNotFound).
+		With("ref", ref).
+		With("file", file).
+		Errorf("no layer in %q contains %q", ref, file)
+}
+
+// splitOCIURL separates an oci:// adapter URL into the image reference and
+// the file to read from it, joined by a "#". The file defaults to
+// "llms.txt" when no fragment is given.
+func splitOCIURL(url string) (ref, file string, err error) {
+	rest := strings.TrimPrefix(url, "oci://")
+	ref, file, found := strings.Cut(rest, "#")
+	if !found {
+		file = defaultOCIFile
+	}
+	if ref == "" {
+		return "", "", errs.TransferErr.
+			Code(errs.CodeInvalidURL).
+			With("url", url).
+			Errorf("oci url must be of the form %q", "oci://registry/repo:tag")
+	}
+	return ref, file, nil
+}
+
+// readTarFile scans a tar stream for name, returning its contents. rc is
+// always closed.
+func readTarFile(rc io.ReadCloser, name string) ([]byte, bool) {
+	defer rc.Close()
+
+	tr := tar.NewReader(rc)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil, false
+		}
+		if err != nil {
+			return nil, false
+		}
+		if hdr.Name != name && !strings.HasSuffix(hdr.Name, "/"+name) {
+			continue
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, false
+		}
+		return data, true
+	}
+}
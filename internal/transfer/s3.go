@@ -0,0 +1,106 @@
+package transfer
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	errs "github.com/g5becks/llxt/internal/errors"
+)
+
+// S3Adapter fetches objects from S3-compatible buckets, for organizations
+// that publish llms.txt alongside other build artifacts. Credentials are
+// resolved lazily from the default AWS chain (environment, shared config,
+// instance profile) on first use, and retried on every call until one
+// succeeds, so a transient config-load failure doesn't wedge the adapter
+// for the rest of the process.
+type S3Adapter struct {
+	mu     sync.Mutex
+	client *s3.Client
+}
+
+// NewS3Adapter returns an S3Adapter.
+func NewS3Adapter() *S3Adapter {
+	return &S3Adapter{}
+}
+
+// Name identifies this adapter for registry hints.
+func (a *S3Adapter) Name() string { return "s3" }
+
+// Supports reports whether url names an S3 object this adapter can fetch.
+func (a *S3Adapter) Supports(url string) bool {
+	return strings.HasPrefix(url, "s3://")
+}
+
+// Fetch downloads the object named by url, e.g. "s3://bucket/key".
+func (a *S3Adapter) Fetch(ctx context.Context, url string) (io.ReadCloser, Meta, error) {
+	bucket, key, err := splitS3URL(url)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	client, err := a.ensureClient(ctx)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	out, err := client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, Meta{}, errs.TransferErr.
+			Code(errs.CodeNetworkFailure).
+			With("bucket", bucket).
+			With("key", key).
+			Wrapf(err, "failed to get S3 object")
+	}
+
+	meta := Meta{}
+	if out.ETag != nil {
+		meta.ETag = strings.Trim(*out.ETag, `"`)
+	}
+	if out.LastModified != nil {
+		meta.LastModified = out.LastModified.UTC().Format(http.TimeFormat)
+	}
+
+	return out.Body, meta, nil
+}
+
+func (a *S3Adapter) ensureClient(ctx context.Context) (*s3.Client, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.client != nil {
+		return a.client, nil
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, errs.TransferErr.
+			Code(errs.CodeConfigLoad).
+			Wrapf(err, "failed to load AWS configuration")
+	}
+
+	a.client = s3.NewFromConfig(cfg)
+	return a.client, nil
+}
+
+// splitS3URL separates a s3:// adapter URL into bucket and key.
+func splitS3URL(url string) (bucket, key string, err error) {
+	rest := strings.TrimPrefix(url, "s3://")
+	bucket, key, found := strings.Cut(rest, "/")
+	if !found || bucket == "" || key == "" {
+		return "", "", errs.TransferErr.
+			Code(errs.CodeInvalidURL).
+			With("url", url).
+			Errorf("s3 url must be of the form %q", "s3://bucket/key")
+	}
+	return bucket, key, nil
+}
@@ -0,0 +1,67 @@
+// Package transfer abstracts how llxt retrieves llms.txt bytes, modeled on
+// the git-lfs batch/transfer-adapter split, so a source can be served over
+// plain HTTPS or routed through git, S3, or an OCI registry without the
+// fetch call sites caring which.
+package transfer
+
+import (
+	"context"
+	"io"
+
+	errs "github.com/g5becks/llxt/internal/errors"
+)
+
+// Meta carries revalidation metadata alongside fetched content. Adapters
+// that have no such concept (git, OCI) leave it zero-valued.
+type Meta struct {
+	ETag         string
+	LastModified string
+}
+
+// Adapter fetches content for URLs it supports.
+type Adapter interface {
+	Name() string
+	Supports(url string) bool
+	Fetch(ctx context.Context, url string) (io.ReadCloser, Meta, error)
+}
+
+// Registry holds the set of adapters dispatch chooses from, tried in
+// registration order.
+type Registry struct {
+	adapters []Adapter
+}
+
+// NewRegistry returns an empty adapter registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register appends an adapter to the dispatch order.
+func (r *Registry) Register(a Adapter) {
+	r.adapters = append(r.adapters, a)
+}
+
+// Resolve returns the adapter to use for url. When hint names a registered
+// adapter, it is preferred outright; otherwise the first adapter whose
+// Supports(url) reports true wins.
+func (r *Registry) Resolve(url, hint string) (Adapter, error) {
+	if hint != "" {
+		for _, a := range r.adapters {
+			if a.Name() == hint {
+				return a, nil
+			}
+		}
+	}
+
+	for _, a := range r.adapters {
+		if a.Supports(url) {
+			return a, nil
+		}
+	}
+
+	return nil, errs.TransferErr.
+		Code(errs.CodeNotFound).
+		With("url", url).
+		With("hint", hint).
+		Errorf("no transfer adapter supports %q", url)
+}
@@ -47,8 +47,11 @@ func WithSpinner(message string, fn func() error) error {
 	return err
 }
 
-// WithProgressBar runs a function with a determinate progress bar.
-func WithProgressBar(message string, total int64, fn func(increment func(n int64)) error) error {
+// WithProgressBar runs a function with a determinate progress bar. fn
+// receives an increment callback and a setTotal callback, so it can revise
+// total downward once the real count of work is known (e.g. a crawl that
+// runs out of pages before reaching an initial upper-bound estimate).
+func WithProgressBar(message string, total int64, fn func(increment, setTotal func(n int64)) error) error {
 	pw := progress.NewWriter()
 	pw.SetOutputWriter(os.Stderr)
 	pw.SetAutoStop(true)
@@ -65,8 +68,11 @@ func WithProgressBar(message string, total int64, fn func(increment func(n int64
 	increment := func(n int64) {
 		tracker.Increment(n)
 	}
+	setTotal := func(n int64) {
+		tracker.UpdateTotal(n)
+	}
 
-	err := fn(increment)
+	err := fn(increment, setTotal)
 
 	if err != nil {
 		tracker.MarkAsErrored()
@@ -79,3 +85,53 @@ func WithProgressBar(message string, total int64, fn func(increment func(n int64
 
 	return err
 }
+
+// MultiProgress renders one indeterminate tracker per named unit of work,
+// for commands that fan work out across several sources concurrently.
+type MultiProgress struct {
+	pw       progress.Writer
+	trackers map[string]*progress.Tracker
+}
+
+// NewMultiProgress starts a tracker for each name and begins rendering to
+// stderr.
+func NewMultiProgress(names []string) *MultiProgress {
+	pw := progress.NewWriter()
+	pw.SetOutputWriter(os.Stderr)
+	pw.SetAutoStop(true)
+	pw.SetTrackerLength(trackerLength)
+	pw.SetStyle(progress.StyleDefault)
+	pw.Style().Visibility.ETA = false
+	pw.Style().Visibility.Percentage = false
+	pw.Style().Visibility.Value = false
+
+	trackers := make(map[string]*progress.Tracker, len(names))
+	for _, name := range names {
+		tracker := &progress.Tracker{Message: name, Total: 0}
+		trackers[name] = tracker
+		pw.AppendTracker(tracker)
+	}
+
+	go pw.Render()
+
+	return &MultiProgress{pw: pw, trackers: trackers}
+}
+
+// Done marks name's tracker as finished, errored if err is non-nil.
+func (m *MultiProgress) Done(name string, err error) {
+	tracker, ok := m.trackers[name]
+	if !ok {
+		return
+	}
+	if err != nil {
+		tracker.MarkAsErrored()
+	} else {
+		tracker.MarkAsDone()
+	}
+}
+
+// Stop waits for the render loop to flush and stops it.
+func (m *MultiProgress) Stop() {
+	time.Sleep(sleepDuration)
+	m.pw.Stop()
+}